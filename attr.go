@@ -19,6 +19,7 @@ import (
 	"crypto/sha1"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"time"
@@ -70,11 +71,17 @@ func (c *AttrCache) Set(id uint64, attrs map[string]interface{}) {
 }
 
 // AttrStore represents a storage layer for attributes.
+//
+// Attributes may optionally be indexed by value so that IDs can be looked up
+// by an attribute without scanning the entire store.
 type AttrStore struct {
 	mu        sync.RWMutex
 	path      string
 	db        *bolt.DB
 	attrCache *AttrCache
+
+	// Set of indexed attribute keys.
+	indexes map[string]struct{}
 }
 
 // NewAttrCache returns a new instance of AttrCache.
@@ -89,6 +96,7 @@ func NewAttrStore(path string) *AttrStore {
 	return &AttrStore{
 		path:      path,
 		attrCache: NewAttrCache(),
+		indexes:   make(map[string]struct{}),
 	}
 }
 
@@ -109,11 +117,27 @@ func (s *AttrStore) Open() error {
 		if _, err := tx.CreateBucketIfNotExists([]byte("attrs")); err != nil {
 			return err
 		}
+		if _, err := tx.CreateBucketIfNotExists([]byte("indexes")); err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucketIfNotExists([]byte("index")); err != nil {
+			return err
+		}
 		return nil
 	}); err != nil {
 		return err
 	}
 
+	// Load the set of indexed keys.
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte("indexes")).ForEach(func(k, _ []byte) error {
+			s.indexes[string(k)] = struct{}{}
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -172,7 +196,7 @@ func (s *AttrStore) SetAttrs(id uint64, m map[string]interface{}) error {
 
 	var attr map[string]interface{}
 	if err := s.db.Update(func(tx *bolt.Tx) error {
-		tmp, err := txUpdateAttrs(tx, id, m)
+		tmp, err := txUpdateAttrs(tx, id, m, s.indexes)
 		if err != nil {
 			return err
 		}
@@ -205,7 +229,7 @@ func (s *AttrStore) SetBulkAttrs(m map[uint64]map[string]interface{}) error {
 
 		// Update attributes for each id.
 		for _, id := range ids {
-			attr, err := txUpdateAttrs(tx, id, m[id])
+			attr, err := txUpdateAttrs(tx, id, m[id], s.indexes)
 			if err != nil {
 				return err
 			}
@@ -288,6 +312,148 @@ func (s *AttrStore) BlockData(i uint64) (map[uint64]map[string]interface{}, erro
 	return m, nil
 }
 
+// IndexedAttrs returns a sorted list of indexed attribute keys.
+func (s *AttrStore) IndexedAttrs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]string, 0, len(s.indexes))
+	for k := range s.indexes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// CreateIndex builds an index on an attribute key from the existing
+// attributes and maintains it on subsequent updates.
+// Creating an index that already exists is a no-op.
+func (s *AttrStore) CreateIndex(key string) error {
+	if key == "" {
+		return ErrAttrKeyRequired
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.indexes[key]; ok {
+		return nil
+	}
+
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		// Index every id which currently has the attribute set.
+		idx := tx.Bucket([]byte("index"))
+		if err := tx.Bucket([]byte("attrs")).ForEach(func(k, v []byte) error {
+			var pb internal.AttrMap
+			if err := proto.Unmarshal(v, &pb); err != nil {
+				return err
+			}
+			for _, attr := range pb.GetAttrs() {
+				if attr.Key != key {
+					continue
+				}
+				_, value := decodeAttr(attr)
+				if err := idx.Put(attrIndexKey(key, value, btou64(k)), nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		return tx.Bucket([]byte("indexes")).Put([]byte(key), nil)
+	}); err != nil {
+		return err
+	}
+
+	s.indexes[key] = struct{}{}
+	return nil
+}
+
+// DeleteIndex removes the index on an attribute key.
+// Deleting an index which does not exist is a no-op.
+func (s *AttrStore) DeleteIndex(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.indexes[key]; !ok {
+		return nil
+	}
+
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		// Collect keys first since deleting while iterating skips entries.
+		var keys [][]byte
+		prefix := attrIndexKeyPrefix(key)
+		cur := tx.Bucket([]byte("index")).Cursor()
+		for k, _ := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cur.Next() {
+			keys = append(keys, k)
+		}
+		for _, k := range keys {
+			if err := tx.Bucket([]byte("index")).Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return tx.Bucket([]byte("indexes")).Delete([]byte(key))
+	}); err != nil {
+		return err
+	}
+
+	delete(s.indexes, key)
+	return nil
+}
+
+// Lookup returns a sorted list of ids where the attribute key equals value.
+//
+// Values must match the stored attribute type exactly. Keys without an index
+// are resolved by scanning every set of attributes in the store.
+func (s *AttrStore) Lookup(key string, value interface{}) ([]uint64, error) {
+	value, err := normalizeAttrValue(value)
+	if err != nil {
+		return nil, err
+	} else if value == nil {
+		return nil, ErrAttrValueRequired
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var ids []uint64
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		// Scan index entries, if available. Entries are ordered by id.
+		if _, ok := s.indexes[key]; ok {
+			prefix := attrIndexValuePrefix(key, value)
+			cur := tx.Bucket([]byte("index")).Cursor()
+			for k, _ := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cur.Next() {
+				ids = append(ids, btou64(k[len(prefix):]))
+			}
+			return nil
+		}
+
+		// Otherwise check every set of attributes.
+		return tx.Bucket([]byte("attrs")).ForEach(func(k, v []byte) error {
+			var pb internal.AttrMap
+			if err := proto.Unmarshal(v, &pb); err != nil {
+				return err
+			}
+			for _, attr := range pb.GetAttrs() {
+				if attr.Key != key {
+					continue
+				}
+				if _, other := decodeAttr(attr); other == value {
+					ids = append(ids, btou64(k))
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
 // txAttrs returns a map of attributes for an id.
 func txAttrs(tx *bolt.Tx, id uint64) (map[string]interface{}, error) {
 	v := tx.Bucket([]byte("attrs")).Get(u64tob(id))
@@ -304,12 +470,25 @@ func txAttrs(tx *bolt.Tx, id uint64) (map[string]interface{}, error) {
 
 // txUpdateAttrs updates the attributes for an id.
 // Returns the new combined set of attributes for the id.
-func txUpdateAttrs(tx *bolt.Tx, id uint64, m map[string]interface{}) (map[string]interface{}, error) {
+// Index entries are updated for any keys in indexes.
+func txUpdateAttrs(tx *bolt.Tx, id uint64, m map[string]interface{}, indexes map[string]struct{}) (map[string]interface{}, error) {
 	attr, err := txAttrs(tx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	// Remove index entries for indexed keys which are being updated.
+	idx := tx.Bucket([]byte("index"))
+	for k := range m {
+		if _, ok := indexes[k]; !ok {
+			continue
+		} else if v, ok := attr[k]; ok {
+			if err := idx.Delete(attrIndexKey(k, v, id)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Create a new map if it is empty so we don't update emptyMap.
 	if len(attr) == 0 {
 		attr = make(map[string]interface{}, len(m))
@@ -323,17 +502,17 @@ func txUpdateAttrs(tx *bolt.Tx, id uint64, m map[string]interface{}) (map[string
 			continue
 		}
 
-		switch v := v.(type) {
-		case int:
-			attr[k] = int64(v)
-		case uint:
-			attr[k] = int64(v)
-		case uint64:
-			attr[k] = int64(v)
-		case string, int64, bool, float64:
-			attr[k] = v
-		default:
-			return nil, fmt.Errorf("invalid attr type: %T", v)
+		v, err := normalizeAttrValue(v)
+		if err != nil {
+			return nil, err
+		}
+		attr[k] = v
+
+		// Add an index entry for the new value.
+		if _, ok := indexes[k]; ok {
+			if err := idx.Put(attrIndexKey(k, v, id), nil); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -348,6 +527,59 @@ func txUpdateAttrs(tx *bolt.Tx, id uint64, m map[string]interface{}) (map[string
 	return attr, nil
 }
 
+// normalizeAttrValue converts v to one of the stored attribute types.
+func normalizeAttrValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), nil
+	case uint:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case nil, string, int64, bool, float64:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("invalid attr type: %T", v)
+	}
+}
+
+// attrIndexKeyPrefix returns the prefix of all index entries for an attribute key.
+func attrIndexKeyPrefix(key string) []byte {
+	buf := make([]byte, 4, 4+len(key))
+	binary.BigEndian.PutUint32(buf, uint32(len(key)))
+	return append(buf, key...)
+}
+
+// attrIndexValuePrefix returns the prefix of all index entries for an
+// attribute key & value. Variable length components are length-prefixed so
+// that one value's prefix never matches another value.
+func attrIndexValuePrefix(key string, value interface{}) []byte {
+	buf := attrIndexKeyPrefix(key)
+	switch value := value.(type) {
+	case string:
+		buf = append(buf, AttrTypeString, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(buf[len(buf)-4:], uint32(len(value)))
+		buf = append(buf, value...)
+	case int64:
+		buf = append(buf, AttrTypeInt)
+		buf = append(buf, u64tob(uint64(value)^(1<<63))...)
+	case bool:
+		buf = append(buf, AttrTypeBool, 0)
+		if value {
+			buf[len(buf)-1] = 1
+		}
+	case float64:
+		buf = append(buf, AttrTypeFloat)
+		buf = append(buf, u64tob(math.Float64bits(value))...)
+	}
+	return buf
+}
+
+// attrIndexKey returns the index entry key for an id's attribute value.
+func attrIndexKey(key string, value interface{}, id uint64) []byte {
+	return append(attrIndexValuePrefix(key, value), u64tob(id)...)
+}
+
 func encodeAttrs(m map[string]interface{}) []*internal.Attr {
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -419,6 +651,21 @@ func cloneAttrs(m map[string]interface{}) map[string]interface{} {
 	return other
 }
 
+// filterAttrs returns the attributes of m in keys. Returns m if keys is empty.
+func filterAttrs(m map[string]interface{}, keys []string) map[string]interface{} {
+	if len(keys) == 0 {
+		return m
+	}
+
+	other := make(map[string]interface{}, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			other[k] = v
+		}
+	}
+	return other
+}
+
 // u64tob encodes v to big endian encoding.
 func u64tob(v uint64) []byte {
 	b := make([]byte, 8)
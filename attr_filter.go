@@ -0,0 +1,251 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pilosa/pilosa/pql"
+)
+
+// AttrFilter is a boolean expression evaluated against a set of attributes.
+type AttrFilter interface {
+	// Match returns true if attrs satisfy the filter.
+	Match(attrs map[string]interface{}) bool
+}
+
+// AttrFilterAnd matches attributes which match every filter.
+type AttrFilterAnd []AttrFilter
+
+// Match returns true if all filters match attrs.
+func (a AttrFilterAnd) Match(attrs map[string]interface{}) bool {
+	for _, f := range a {
+		if !f.Match(attrs) {
+			return false
+		}
+	}
+	return true
+}
+
+// AttrFilterOr matches attributes which match at least one filter.
+type AttrFilterOr []AttrFilter
+
+// Match returns true if any filter matches attrs.
+func (a AttrFilterOr) Match(attrs map[string]interface{}) bool {
+	for _, f := range a {
+		if f.Match(attrs) {
+			return true
+		}
+	}
+	return false
+}
+
+// AttrCondition compares a single attribute to a value.
+//
+// Equality operators accept any value type and a nil value matches a missing
+// attribute. Ordering operators & BETWEEN only match numeric attributes.
+type AttrCondition struct {
+	Key   string
+	Op    pql.Token
+	Value interface{}
+}
+
+// Match returns true if the attribute satisfies the condition.
+func (c *AttrCondition) Match(attrs map[string]interface{}) bool {
+	v, ok := attrs[c.Key]
+	if !ok || v == nil {
+		switch c.Op {
+		case pql.EQ:
+			return c.Value == nil
+		default:
+			return false
+		}
+	}
+
+	switch c.Op {
+	case pql.EQ:
+		return attrValueEqual(v, c.Value)
+	case pql.NEQ:
+		return !attrValueEqual(v, c.Value)
+	case pql.LT:
+		cmp, ok := compareAttrValues(v, c.Value)
+		return ok && cmp < 0
+	case pql.LTE:
+		cmp, ok := compareAttrValues(v, c.Value)
+		return ok && cmp <= 0
+	case pql.GT:
+		cmp, ok := compareAttrValues(v, c.Value)
+		return ok && cmp > 0
+	case pql.GTE:
+		cmp, ok := compareAttrValues(v, c.Value)
+		return ok && cmp >= 0
+	case pql.BETWEEN:
+		bounds := c.Value.([]interface{})
+		lo, ok0 := compareAttrValues(v, bounds[0])
+		hi, ok1 := compareAttrValues(v, bounds[1])
+		return ok0 && ok1 && lo >= 0 && hi <= 0
+	default:
+		return false
+	}
+}
+
+// AttrPrefix matches string attributes which begin with a prefix.
+type AttrPrefix struct {
+	Key    string
+	Prefix string
+}
+
+// Match returns true if the attribute is a string starting with the prefix.
+func (p *AttrPrefix) Match(attrs map[string]interface{}) bool {
+	s, ok := attrs[p.Key].(string)
+	return ok && strings.HasPrefix(s, p.Prefix)
+}
+
+// ParseAttrFilter converts a PQL call into an attribute filter.
+//
+// And() & Or() accept conditions, such as tier >= 2, and nested filter calls.
+// Prefix() accepts a single string argument, such as Prefix(name="ab").
+func ParseAttrFilter(c *pql.Call) (AttrFilter, error) {
+	switch c.Name {
+	case "And", "Or":
+		var a []AttrFilter
+		for _, child := range c.Children {
+			f, err := ParseAttrFilter(child)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, f)
+		}
+		for _, key := range c.Keys() {
+			cond, ok := c.Args[key].(*pql.Condition)
+			if !ok {
+				return nil, fmt.Errorf("%s(): expected condition for %q", c.Name, key)
+			}
+			f, err := newAttrCondition(key, cond)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, f)
+		}
+		if len(a) == 0 {
+			return nil, fmt.Errorf("%s(): condition required", c.Name)
+		}
+
+		if c.Name == "And" {
+			return AttrFilterAnd(a), nil
+		}
+		return AttrFilterOr(a), nil
+
+	case "Prefix":
+		if len(c.Children) > 0 || len(c.Args) != 1 {
+			return nil, errors.New("Prefix(): exactly one argument required")
+		}
+		for key, v := range c.Args {
+			prefix, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("Prefix(): string required for %q", key)
+			}
+			return &AttrPrefix{Key: key, Prefix: prefix}, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid attribute filter: %s()", c.Name)
+}
+
+// newAttrCondition validates a PQL condition on an attribute.
+func newAttrCondition(key string, cond *pql.Condition) (*AttrCondition, error) {
+	switch cond.Op {
+	case pql.EQ, pql.NEQ:
+	case pql.LT, pql.LTE, pql.GT, pql.GTE:
+		if !isAttrNumber(cond.Value) {
+			return nil, fmt.Errorf("numeric value required for %q", key)
+		}
+	case pql.BETWEEN:
+		bounds, ok := cond.Value.([]interface{})
+		if !ok || len(bounds) != 2 || !isAttrNumber(bounds[0]) || !isAttrNumber(bounds[1]) {
+			return nil, fmt.Errorf("two numeric values required for %q", key)
+		}
+	default:
+		return nil, ErrInvalidRangeOperation
+	}
+	return &AttrCondition{Key: key, Op: cond.Op, Value: cond.Value}, nil
+}
+
+// isAttrNumber returns true if v is a numeric attribute value.
+func isAttrNumber(v interface{}) bool {
+	switch v.(type) {
+	case int64, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// attrValueEqual returns true if two attribute values are equal.
+// Integers and floats with the same value are considered equal.
+func attrValueEqual(a, b interface{}) bool {
+	if cmp, ok := compareAttrValues(a, b); ok {
+		return cmp == 0
+	}
+	return a == b
+}
+
+// compareAttrValues compares two numeric attribute values. Returns false if
+// either value is not numeric.
+func compareAttrValues(a, b interface{}) (int, bool) {
+	// Compare integers exactly.
+	if x, ok := a.(int64); ok {
+		if y, ok := b.(int64); ok {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+	}
+
+	x, ok := attrFloat(a)
+	if !ok {
+		return 0, false
+	}
+	y, ok := attrFloat(b)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+// attrFloat converts a numeric attribute value to a float64.
+func attrFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
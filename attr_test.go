@@ -18,6 +18,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/pilosa/pilosa"
 	"github.com/pilosa/pilosa/test"
 )
 
@@ -123,3 +124,88 @@ func TestAttrStore_Blocks(t *testing.T) {
 		t.Fatalf("block 2 mismatch: %#v != %#v", blks0[2], blks1[2])
 	}
 }
+
+// Ensure store can look up ids by attribute value with & without an index.
+func TestAttrStore_Lookup(t *testing.T) {
+	s := test.MustOpenAttrStore()
+	defer s.Close()
+
+	// Set attributes before the index exists.
+	if err := s.SetAttrs(1, map[string]interface{}{"country": "DE", "tier": 1}); err != nil {
+		t.Fatal(err)
+	} else if err := s.SetAttrs(2, map[string]interface{}{"country": "US", "tier": 2}); err != nil {
+		t.Fatal(err)
+	} else if err := s.SetAttrs(3, map[string]interface{}{"country": "DE"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unindexed keys are scanned.
+	if ids, err := s.Lookup("country", "DE"); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1, 3}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	// Build indexes from existing attributes.
+	if err := s.CreateIndex("country"); err != nil {
+		t.Fatal(err)
+	} else if err := s.CreateIndex("tier"); err != nil {
+		t.Fatal(err)
+	} else if keys := s.IndexedAttrs(); !reflect.DeepEqual(keys, []string{"country", "tier"}) {
+		t.Fatalf("unexpected indexed attrs: %v", keys)
+	}
+
+	if ids, err := s.Lookup("country", "DE"); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1, 3}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+	if ids, err := s.Lookup("tier", 2); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{2}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	// Update, delete & bulk set attributes.
+	if err := s.SetAttrs(1, map[string]interface{}{"country": "FR"}); err != nil {
+		t.Fatal(err)
+	} else if err := s.SetAttrs(3, map[string]interface{}{"country": nil}); err != nil {
+		t.Fatal(err)
+	} else if err := s.SetBulkAttrs(map[uint64]map[string]interface{}{
+		4: {"country": "DE"},
+		5: {"country": "DEU"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids, err := s.Lookup("country", "DE"); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{4}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+	if ids, err := s.Lookup("country", "FR"); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	// Indexes persist after reopening the store.
+	if err := s.AttrStore.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s.AttrStore = pilosa.NewAttrStore(s.Path())
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	} else if keys := s.IndexedAttrs(); !reflect.DeepEqual(keys, []string{"country", "tier"}) {
+		t.Fatalf("unexpected indexed attrs after reopen: %v", keys)
+	}
+
+	// Deleting the index falls back to scanning.
+	if err := s.DeleteIndex("country"); err != nil {
+		t.Fatal(err)
+	} else if ids, err := s.Lookup("country", "DEU"); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{5}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+}
@@ -17,6 +17,8 @@ package pilosa
 // #cgo  CFLAGS:-mpopcnt
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"sort"
 
@@ -30,6 +32,76 @@ type Bitmap struct {
 
 	// Attributes associated with the bitmap.
 	Attrs map[string]interface{}
+
+	// Keys associated with the bits, if translation is enabled.
+	Keys []string
+
+	// Cursor for the next page of bits, if the bits were paginated.
+	Cursor string
+
+	// Bits serialized in a portable roaring format, if requested.
+	Roaring []byte
+}
+
+// Portable roaring formats used to exchange bitmaps with other systems.
+const (
+	BitmapFormatRoaring32 = "roaring32"
+	BitmapFormatRoaring64 = "roaring64"
+)
+
+// IsValidBitmapFormat returns true if format is a supported bitmap format.
+func IsValidBitmapFormat(format string) bool {
+	switch format {
+	case BitmapFormatRoaring32, BitmapFormatRoaring64:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalRoaring returns the bits in b encoded in a portable roaring format.
+func (b *Bitmap) MarshalRoaring(format string) ([]byte, error) {
+	bm := roaring.NewBitmap()
+	for i := range b.segments {
+		bm = bm.Union(&b.segments[i].data)
+	}
+	return encodeRoaring(bm, format)
+}
+
+// encodeRoaring encodes bm in a portable roaring format.
+func encodeRoaring(bm *roaring.Bitmap, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case BitmapFormatRoaring32:
+		if _, err := bm.WritePortable32To(&buf); err != nil {
+			return nil, err
+		}
+	case BitmapFormatRoaring64:
+		if _, err := bm.WritePortable64To(&buf); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidBitmapFormat
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeRoaring decodes data in a portable roaring format.
+func decodeRoaring(data []byte, format string) (*roaring.Bitmap, error) {
+	bm := roaring.NewBitmap()
+	switch format {
+	case BitmapFormatRoaring32:
+		if err := bm.UnmarshalPortable32(data); err != nil {
+			return nil, err
+		}
+	case BitmapFormatRoaring64:
+		if err := bm.UnmarshalPortable64(data); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidBitmapFormat
+	}
+	return bm, nil
 }
 
 // NewBitmap returns a new instance of Bitmap.
@@ -41,6 +113,17 @@ func NewBitmap(bits ...uint64) *Bitmap {
 	return bm
 }
 
+// Clone returns a copy of b which doesn't share bitmap data with b. The copy
+// is read-only so it can be shared; writes to it are made to a new copy.
+func (b *Bitmap) Clone() *Bitmap {
+	other := *b
+	other.segments = make([]BitmapSegment, len(b.segments))
+	for i, s := range b.segments {
+		other.segments[i] = BitmapSegment{slice: s.slice, data: *s.data.Clone(), n: s.n}
+	}
+	return &other
+}
+
 // Merge merges data from other into b.
 func (b *Bitmap) Merge(other *Bitmap) {
 	var segments []BitmapSegment
@@ -153,6 +236,79 @@ func (b *Bitmap) Difference(other *Bitmap) *Bitmap {
 	return &Bitmap{segments: segments}
 }
 
+// DifferenceInPlace removes the bits in other from b. The caller must own b.
+// Segments which aren't writable are copied before they are modified.
+func (b *Bitmap) DifferenceInPlace(other *Bitmap) {
+	for i := range b.segments {
+		if s := other.segment(b.segments[i].slice); s != nil {
+			b.segments[i].DifferenceInPlace(s)
+		}
+	}
+}
+
+// XorInPlace sets b to the xor of b and other. The caller must own b.
+// Segments which aren't writable are copied before they are modified.
+func (b *Bitmap) XorInPlace(other *Bitmap) {
+	for i := range other.segments {
+		s := &other.segments[i]
+		if seg := b.segment(s.slice); seg != nil {
+			seg.XorInPlace(s)
+			continue
+		}
+
+		seg := b.createSegmentIfNotExists(s.slice)
+		seg.data, seg.n = *s.data.Clone(), s.n
+	}
+}
+
+// UnionBitmaps returns the union of bitmaps. Segments for the same slice are
+// merged across all bitmaps at once.
+func UnionBitmaps(bitmaps ...*Bitmap) *Bitmap {
+	var segments []BitmapSegment
+	for _, group := range groupSegmentsBySlice(bitmaps) {
+		segments = append(segments, *unionSegments(group))
+	}
+	return &Bitmap{segments: segments}
+}
+
+// IntersectBitmaps returns the intersection of bitmaps. Segments for the same
+// slice are intersected across all bitmaps at once.
+func IntersectBitmaps(bitmaps ...*Bitmap) *Bitmap {
+	var segments []BitmapSegment
+	for _, group := range groupSegmentsBySlice(bitmaps) {
+		// Ignore slices which are missing from any bitmap.
+		if len(group) != len(bitmaps) {
+			continue
+		}
+		segments = append(segments, *intersectSegments(group))
+	}
+	return &Bitmap{segments: segments}
+}
+
+// groupSegmentsBySlice returns the segments of bitmaps grouped by slice,
+// in slice order.
+func groupSegmentsBySlice(bitmaps []*Bitmap) [][]*BitmapSegment {
+	m := make(map[uint64][]*BitmapSegment)
+	for _, bm := range bitmaps {
+		for i := range bm.segments {
+			s := &bm.segments[i]
+			m[s.slice] = append(m[s.slice], s)
+		}
+	}
+
+	slices := make([]uint64, 0, len(m))
+	for slice := range m {
+		slices = append(slices, slice)
+	}
+	sort.Sort(uint64Slice(slices))
+
+	groups := make([][]*BitmapSegment, len(slices))
+	for i, slice := range slices {
+		groups[i] = m[slice]
+	}
+	return groups
+}
+
 // SetBit sets the i-th bit of the bitmap.
 func (b *Bitmap) SetBit(i uint64) (changed bool) {
 	return b.createSegmentIfNotExists(i / SliceWidth).SetBit(i)
@@ -239,10 +395,16 @@ func (b *Bitmap) Count() uint64 {
 // MarshalJSON returns a JSON-encoded byte slice of b.
 func (b *Bitmap) MarshalJSON() ([]byte, error) {
 	var o struct {
-		Attrs map[string]interface{} `json:"attrs"`
-		Bits  []uint64               `json:"bits"`
+		Attrs   map[string]interface{} `json:"attrs"`
+		Bits    []uint64               `json:"bits"`
+		Keys    []string               `json:"keys,omitempty"`
+		Cursor  string                 `json:"cursor,omitempty"`
+		Roaring []byte                 `json:"roaring,omitempty"`
 	}
 	o.Bits = b.Bits()
+	o.Keys = b.Keys
+	o.Cursor = b.Cursor
+	o.Roaring = b.Roaring
 
 	o.Attrs = b.Attrs
 	if o.Attrs == nil {
@@ -268,8 +430,11 @@ func encodeBitmap(b *Bitmap) *internal.Bitmap {
 	}
 
 	return &internal.Bitmap{
-		Bits:  b.Bits(),
-		Attrs: encodeAttrs(b.Attrs),
+		Bits:    b.Bits(),
+		Attrs:   encodeAttrs(b.Attrs),
+		Keys:    b.Keys,
+		Cursor:  b.Cursor,
+		Roaring: b.Roaring,
 	}
 }
 
@@ -281,12 +446,34 @@ func decodeBitmap(pb *internal.Bitmap) *Bitmap {
 
 	b := NewBitmap()
 	b.Attrs = decodeAttrs(pb.Attrs)
+	b.Keys = pb.Keys
+	b.Cursor = pb.Cursor
+	b.Roaring = pb.Roaring
 	for _, v := range pb.Bits {
 		b.SetBit(v)
 	}
 	return b
 }
 
+// encodeCursor returns an opaque cursor which resumes a page at column id.
+func encodeCursor(id uint64) string {
+	return base64.RawURLEncoding.EncodeToString(u64tob(id))
+}
+
+// decodeCursor returns the column id to resume a page from.
+// A blank cursor starts from the first column.
+func decodeCursor(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(buf) != 8 {
+		return 0, ErrInvalidCursor
+	}
+	return btou64(buf), nil
+}
+
 // Union performs a union on a slice of bitmaps.
 func Union(bitmaps []*Bitmap) *Bitmap {
 	other := bitmaps[0]
@@ -351,28 +538,74 @@ func (s *BitmapSegment) Union(other *BitmapSegment) *BitmapSegment {
 	}
 }
 
+// unionSegments returns the bitwise union of segments for the same slice.
+func unionSegments(segments []*BitmapSegment) *BitmapSegment {
+	a := make([]*roaring.Bitmap, len(segments))
+	for i, s := range segments {
+		a[i] = &s.data
+	}
+	data := roaring.Union(a...)
+
+	return &BitmapSegment{
+		data:  *data,
+		slice: segments[0].slice,
+		n:     data.Count(),
+	}
+}
+
+// intersectSegments returns the intersection of segments for the same slice.
+func intersectSegments(segments []*BitmapSegment) *BitmapSegment {
+	a := make([]*roaring.Bitmap, len(segments))
+	for i, s := range segments {
+		a[i] = &s.data
+	}
+	data := roaring.Intersect(a...)
+
+	return &BitmapSegment{
+		data:  *data,
+		slice: segments[0].slice,
+		n:     data.Count(),
+	}
+}
+
 // Difference returns the diff of s and other.
 func (s *BitmapSegment) Difference(other *BitmapSegment) *BitmapSegment {
 	data := s.data.Difference(&other.data)
 
 	return &BitmapSegment{
-		data:  *data,
-		slice: s.slice,
-		n:     data.Count(),
+		data:     *data,
+		slice:    s.slice,
+		writable: true,
+		n:        data.Count(),
 	}
 }
 
+// DifferenceInPlace removes the bits in other from s.
+func (s *BitmapSegment) DifferenceInPlace(other *BitmapSegment) {
+	s.ensureWritable()
+	s.data.DifferenceInPlace(&other.data)
+	s.InvalidateCount()
+}
+
 // Xor returns the xor of s and other.
 func (s *BitmapSegment) Xor(other *BitmapSegment) *BitmapSegment {
 	data := s.data.Xor(&other.data)
 
 	return &BitmapSegment{
-		data:  *data,
-		slice: s.slice,
-		n:     data.Count(),
+		data:     *data,
+		slice:    s.slice,
+		writable: true,
+		n:        data.Count(),
 	}
 }
 
+// XorInPlace sets s to the xor of s and other.
+func (s *BitmapSegment) XorInPlace(other *BitmapSegment) {
+	s.ensureWritable()
+	s.data.XorInPlace(&other.data)
+	s.InvalidateCount()
+}
+
 // SetBit sets the i-th bit of the bitmap.
 func (s *BitmapSegment) SetBit(i uint64) (changed bool) {
 	s.ensureWritable()
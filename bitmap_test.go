@@ -77,6 +77,56 @@ func TestBitmap_Union_Segment(t *testing.T) {
 	}
 }
 
+// Ensure bitmaps can be combined across multiple slices at once.
+func TestBitmaps_UnionIntersect(t *testing.T) {
+	bm1 := pilosa.NewBitmap(0, 1, SliceWidth, SliceWidth+1)
+	bm2 := pilosa.NewBitmap(1, SliceWidth+1, 2*SliceWidth)
+	bm3 := pilosa.NewBitmap(1, 2, SliceWidth+1)
+
+	if res := pilosa.UnionBitmaps(bm1, bm2, bm3); res.Count() != 6 {
+		t.Fatalf("unexpected count: %d", res.Count())
+	} else if exp := []uint64{0, 1, 2, SliceWidth, SliceWidth + 1, 2 * SliceWidth}; !reflect.DeepEqual(res.Bits(), exp) {
+		t.Fatalf("unexpected bits: %v", res.Bits())
+	}
+
+	if res := pilosa.IntersectBitmaps(bm1, bm2, bm3); res.Count() != 2 {
+		t.Fatalf("unexpected count: %d", res.Count())
+	} else if exp := []uint64{1, SliceWidth + 1}; !reflect.DeepEqual(res.Bits(), exp) {
+		t.Fatalf("unexpected bits: %v", res.Bits())
+	}
+
+	// Inputs are not modified.
+	if exp := []uint64{0, 1, SliceWidth, SliceWidth + 1}; !reflect.DeepEqual(bm1.Bits(), exp) {
+		t.Fatalf("unexpected input bits: %v", bm1.Bits())
+	}
+}
+
+// Ensure bitmaps can be modified in place without changing their inputs.
+func TestBitmap_InPlace(t *testing.T) {
+	bm1 := pilosa.NewBitmap(0, 1, SliceWidth, SliceWidth+1)
+	bm2 := pilosa.NewBitmap(1, SliceWidth+1, 2*SliceWidth)
+
+	res := bm1.Clone()
+	if res.XorInPlace(bm2); !reflect.DeepEqual(res.Bits(), []uint64{0, SliceWidth, 2 * SliceWidth}) {
+		t.Fatalf("unexpected xor bits: %v", res.Bits())
+	} else if res.Count() != 3 {
+		t.Fatalf("unexpected xor count: %d", res.Count())
+	}
+
+	if res.DifferenceInPlace(bm1); !reflect.DeepEqual(res.Bits(), []uint64{2 * SliceWidth}) {
+		t.Fatalf("unexpected difference bits: %v", res.Bits())
+	} else if res.Count() != 1 {
+		t.Fatalf("unexpected difference count: %d", res.Count())
+	}
+
+	// Inputs are not modified.
+	if exp := []uint64{0, 1, SliceWidth, SliceWidth + 1}; !reflect.DeepEqual(bm1.Bits(), exp) {
+		t.Fatalf("unexpected input bits: %v", bm1.Bits())
+	} else if exp := []uint64{1, SliceWidth + 1, 2 * SliceWidth}; !reflect.DeepEqual(bm2.Bits(), exp) {
+		t.Fatalf("unexpected input bits: %v", bm2.Bits())
+	}
+}
+
 func TestBitmap_Difference_Segment(t *testing.T) {
 	bm1 := pilosa.NewBitmap(0, 1, SliceWidth)
 	bm2 := pilosa.NewBitmap(0, 2*SliceWidth)
@@ -116,6 +116,13 @@ const (
 	MessageTypeCreateInputDefinition = 6
 	MessageTypeDeleteInputDefinition = 7
 	MessageTypeDeleteView            = 8
+	MessageTypeCreateField           = 9
+	MessageTypeDeleteField           = 10
+	MessageTypeSetIndexTimeQuantum   = 11
+	MessageTypeSetFrameTimeQuantum   = 12
+	MessageTypeRerangeField          = 13
+	MessageTypeCreateAttrIndex       = 14
+	MessageTypeDeleteAttrIndex       = 15
 )
 
 // MarshalMessage encodes the protobuf message into a byte slice.
@@ -138,6 +145,20 @@ func MarshalMessage(m proto.Message) ([]byte, error) {
 		typ = MessageTypeDeleteInputDefinition
 	case *internal.DeleteViewMessage:
 		typ = MessageTypeDeleteView
+	case *internal.CreateFieldMessage:
+		typ = MessageTypeCreateField
+	case *internal.DeleteFieldMessage:
+		typ = MessageTypeDeleteField
+	case *internal.SetIndexTimeQuantumMessage:
+		typ = MessageTypeSetIndexTimeQuantum
+	case *internal.SetFrameTimeQuantumMessage:
+		typ = MessageTypeSetFrameTimeQuantum
+	case *internal.RerangeFieldMessage:
+		typ = MessageTypeRerangeField
+	case *internal.CreateAttrIndexMessage:
+		typ = MessageTypeCreateAttrIndex
+	case *internal.DeleteAttrIndexMessage:
+		typ = MessageTypeDeleteAttrIndex
 	default:
 		return nil, fmt.Errorf("message type not implemented for marshalling: %s", reflect.TypeOf(obj))
 	}
@@ -170,6 +191,20 @@ func UnmarshalMessage(buf []byte) (proto.Message, error) {
 		m = &internal.DeleteInputDefinitionMessage{}
 	case MessageTypeDeleteView:
 		m = &internal.DeleteViewMessage{}
+	case MessageTypeCreateField:
+		m = &internal.CreateFieldMessage{}
+	case MessageTypeDeleteField:
+		m = &internal.DeleteFieldMessage{}
+	case MessageTypeSetIndexTimeQuantum:
+		m = &internal.SetIndexTimeQuantumMessage{}
+	case MessageTypeSetFrameTimeQuantum:
+		m = &internal.SetFrameTimeQuantumMessage{}
+	case MessageTypeRerangeField:
+		m = &internal.RerangeFieldMessage{}
+	case MessageTypeCreateAttrIndex:
+		m = &internal.CreateAttrIndexMessage{}
+	case MessageTypeDeleteAttrIndex:
+		m = &internal.DeleteAttrIndexMessage{}
 	default:
 		return nil, fmt.Errorf("invalid message type: %d", typ)
 	}
@@ -34,6 +34,48 @@ func TestMessage_Marshal(t *testing.T) {
 	testMessageMarshal(t, &internal.DeleteIndexMessage{
 		Index: "i",
 	})
+
+	testMessageMarshal(t, &internal.CreateFieldMessage{
+		Index: "i",
+		Frame: "f",
+		Field: &internal.Field{Name: "x", Type: "int", Min: -10, Max: 100},
+	})
+
+	testMessageMarshal(t, &internal.DeleteFieldMessage{
+		Index: "i",
+		Frame: "f",
+		Field: "x",
+	})
+
+	testMessageMarshal(t, &internal.SetIndexTimeQuantumMessage{
+		Index:       "i",
+		TimeQuantum: "YMD",
+	})
+
+	testMessageMarshal(t, &internal.SetFrameTimeQuantumMessage{
+		Index:       "i",
+		Frame:       "f",
+		TimeQuantum: "YM",
+	})
+
+	testMessageMarshal(t, &internal.RerangeFieldMessage{
+		Index: "i",
+		Frame: "f",
+		Field: "x",
+		Min:   -10,
+		Max:   100,
+	})
+
+	testMessageMarshal(t, &internal.CreateAttrIndexMessage{
+		Index: "i",
+		Frame: "f",
+		Attr:  "owner",
+	})
+
+	testMessageMarshal(t, &internal.DeleteAttrIndexMessage{
+		Index: "i",
+		Attr:  "country",
+	})
 }
 
 func testMessageMarshal(t *testing.T, m proto.Message) {
@@ -164,10 +164,19 @@ func NewRankCache(maxEntries uint32) *RankCache {
 	}
 }
 
-// Add adds a count to the cache.
+// Add adds a count to the cache. A zero count removes the entry since the
+// row no longer has any bits set.
 func (c *RankCache) Add(id uint64, n uint64) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	if n == 0 {
+		if _, ok := c.entries[id]; ok {
+			delete(c.entries, id)
+			c.invalidate()
+		}
+		return
+	}
+
 	// Ignore if the bit count is below the threshold.
 	if n < c.thresholdValue {
 		return
@@ -314,19 +323,22 @@ func (p BitmapPairs) Less(i, j int) bool { return p[i].Count > p[j].Count }
 // Pair holds an id/count pair.
 type Pair struct {
 	ID    uint64 `json:"id"`
+	Key   string `json:"key,omitempty"`
 	Count uint64 `json:"count"`
 }
 
 func encodePair(p Pair) *internal.Pair {
 	return &internal.Pair{
-		Key:   p.ID,
-		Count: p.Count,
+		Key:       p.ID,
+		StringKey: p.Key,
+		Count:     p.Count,
 	}
 }
 
 func decodePair(pb *internal.Pair) Pair {
 	return Pair{
 		ID:    pb.Key,
+		Key:   pb.StringKey,
 		Count: pb.Count,
 	}
 }
@@ -15,6 +15,7 @@
 package pilosa_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/pilosa/pilosa"
@@ -33,3 +34,28 @@ func TestCache_Rank(t *testing.T) {
 	}
 
 }
+
+// Ensure adding a zero count removes the entry from a rank cache.
+func TestCache_Rank_AddZero(t *testing.T) {
+	cache := pilosa.NewRankCache(10)
+	cache.Add(1, 3)
+	cache.Add(2, 5)
+	cache.Recalculate()
+
+	// Removing an existing row drops it from the entries & rankings.
+	cache.Add(1, 0)
+	cache.Recalculate()
+	if n := cache.Get(1); n != 0 {
+		t.Fatalf("unexpected count: %d", n)
+	} else if ids := cache.IDs(); !reflect.DeepEqual(ids, []uint64{2}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	} else if top := cache.Top(); !reflect.DeepEqual(top, []pilosa.BitmapPair{{ID: 2, Count: 5}}) {
+		t.Fatalf("unexpected top: %v", top)
+	}
+
+	// A zero count for an unknown row does not add an entry.
+	cache.Add(3, 0)
+	if n := cache.Len(); n != 1 {
+		t.Fatalf("unexpected cache size: %d", n)
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"crypto/tls"
@@ -253,6 +254,15 @@ func (c *InternalHTTPClient) ExecuteQuery(ctx context.Context, index string, que
 		return nil, ErrQueryRequired
 	}
 
+	// Forward the time remaining before the deadline to the remote node.
+	if deadline, ok := ctx.Deadline(); ok && queryRequest.Timeout == 0 {
+		timeout := time.Until(deadline)
+		if timeout <= 0 {
+			return nil, context.DeadlineExceeded
+		}
+		queryRequest.Timeout = int64(timeout)
+	}
+
 	// Encode request object.
 	buf, err := proto.Marshal(queryRequest)
 	if err != nil {
@@ -274,6 +284,9 @@ func (c *InternalHTTPClient) ExecuteQuery(ctx context.Context, index string, que
 	// Execute request against the host.
 	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
@@ -282,6 +295,8 @@ func (c *InternalHTTPClient) ExecuteQuery(ctx context.Context, index string, que
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
+	} else if resp.StatusCode == http.StatusGatewayTimeout {
+		return nil, context.DeadlineExceeded
 	} else if resp.StatusCode != http.StatusOK {
 		return nil, errors.New(string(body))
 	}
@@ -289,8 +304,8 @@ func (c *InternalHTTPClient) ExecuteQuery(ctx context.Context, index string, que
 	qresp := &internal.QueryResponse{}
 	if err := proto.Unmarshal(body, qresp); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %s", err)
-	} else if s := qresp.Err; s != "" {
-		return nil, errors.New(s)
+	} else if err := decodeError(qresp.Err); err != nil {
+		return nil, err
 	}
 
 	return qresp, nil
@@ -435,15 +450,17 @@ func marshalImportValuePayload(index, frame, field string, slice uint64, vals []
 	// Separate row and column IDs to reduce allocations.
 	columnIDs := FieldValues(vals).ColumnIDs()
 	values := FieldValues(vals).Values()
+	decimalValues := FieldValues(vals).DecimalValues()
 
 	// Marshal bits to protobufs.
 	buf, err := proto.Marshal(&internal.ImportValueRequest{
-		Index:     index,
-		Frame:     frame,
-		Slice:     slice,
-		Field:     field,
-		ColumnIDs: columnIDs,
-		Values:    values,
+		Index:         index,
+		Frame:         frame,
+		Slice:         slice,
+		Field:         field,
+		ColumnIDs:     columnIDs,
+		Values:        values,
+		DecimalValues: decimalValues,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("marshal import request: %s", err)
@@ -495,7 +512,7 @@ func (c *InternalHTTPClient) ExportCSV(ctx context.Context, index, frame, view s
 		return ErrIndexRequired
 	} else if frame == "" {
 		return ErrFrameRequired
-	} else if !(view == ViewStandard || view == ViewInverse) {
+	} else if !(view == ViewStandard || view == ViewInverse || strings.HasPrefix(view, ViewFieldPrefix)) {
 		return ErrInvalidView
 	}
 
@@ -1066,6 +1083,83 @@ func (c *InternalHTTPClient) RowAttrDiff(ctx context.Context, index, frame strin
 	return rsp.Attrs, nil
 }
 
+// TranslateKeys returns the IDs for a list of column keys in an index or,
+// if frame is specified, for a list of row keys in a frame.
+func (c *InternalHTTPClient) TranslateKeys(ctx context.Context, index, frame string, keys []string) ([]uint64, error) {
+	var rsp internal.TranslateKeysResponse
+	if err := c.translate(ctx, "/translate/keys", &internal.TranslateKeysRequest{
+		Index: index,
+		Frame: frame,
+		Keys:  keys,
+	}, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.IDs, nil
+}
+
+// LookupKeys returns the IDs for a list of keys like TranslateKeys but
+// does not allocate IDs for unknown keys.
+func (c *InternalHTTPClient) LookupKeys(ctx context.Context, index, frame string, keys []string) ([]uint64, error) {
+	var rsp internal.TranslateKeysResponse
+	if err := c.translate(ctx, "/translate/keys", &internal.TranslateKeysRequest{
+		Index:      index,
+		Frame:      frame,
+		Keys:       keys,
+		LookupOnly: true,
+	}, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.IDs, nil
+}
+
+// TranslateIDs returns the keys for a list of column IDs in an index or,
+// if frame is specified, for a list of row IDs in a frame.
+func (c *InternalHTTPClient) TranslateIDs(ctx context.Context, index, frame string, ids []uint64) ([]string, error) {
+	var rsp internal.TranslateIDsResponse
+	if err := c.translate(ctx, "/translate/ids", &internal.TranslateIDsRequest{
+		Index: index,
+		Frame: frame,
+		IDs:   ids,
+	}, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.Keys, nil
+}
+
+// translate sends a translation request to path and decodes the response into rsp.
+func (c *InternalHTTPClient) translate(ctx context.Context, path string, pbreq, rsp proto.Message) error {
+	buf, err := proto.Marshal(pbreq)
+	if err != nil {
+		return err
+	}
+
+	u := c.clientURI(ctx).Path(path)
+	req, err := http.NewRequest("POST", u, bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Length", strconv.Itoa(len(buf)))
+	req.Header.Set("Accept", "application/x-protobuf")
+	req.Header.Set("User-Agent", "pilosa/"+Version)
+
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Read body and return error if status is not OK.
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return errors.New(strings.TrimSpace(string(body)))
+	}
+
+	return proto.Unmarshal(body, rsp)
+}
+
 func (c *InternalHTTPClient) clientURI(ctx context.Context) *URI {
 	clientURI := c.defaultURI
 	if contextURI, ok := ctx.Value("uri").(*URI); ok {
@@ -1142,9 +1236,13 @@ func (p Bits) GroupBySlice() map[uint64][]Bit {
 
 // FieldValues represents the value for a column within a
 // range-encoded frame.
+//
+// DecimalValue is used for decimal fields. If it is blank then Value is used.
+// Decimals are sent as strings so they are scaled without loss of precision.
 type FieldValue struct {
-	ColumnID uint64
-	Value    int64
+	ColumnID     uint64
+	Value        int64
+	DecimalValue string
 }
 
 // FieldValues represents a slice of field values.
@@ -1175,6 +1273,31 @@ func (p FieldValues) Values() []int64 {
 	return other
 }
 
+// DecimalValues returns a slice of all the values as decimal strings.
+// Returns nil if no value has a decimal value set.
+func (p FieldValues) DecimalValues() []string {
+	var ok bool
+	for i := range p {
+		if p[i].DecimalValue != "" {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return nil
+	}
+
+	other := make([]string, len(p))
+	for i := range p {
+		if p[i].DecimalValue != "" {
+			other[i] = p[i].DecimalValue
+		} else {
+			other[i] = strconv.FormatInt(p[i].Value, 10)
+		}
+	}
+	return other
+}
+
 // GroupBySlice returns a map of field values by slice.
 func (p FieldValues) GroupBySlice() map[uint64][]FieldValue {
 	m := make(map[uint64][]FieldValue)
@@ -1248,4 +1371,7 @@ type InternalClient interface {
 	BlockData(ctx context.Context, index, frame, view string, slice uint64, block int) ([]uint64, []uint64, error)
 	ColumnAttrDiff(ctx context.Context, index string, blks []AttrBlock) (map[uint64]map[string]interface{}, error)
 	RowAttrDiff(ctx context.Context, index, frame string, blks []AttrBlock) (map[uint64]map[string]interface{}, error)
+	TranslateKeys(ctx context.Context, index, frame string, keys []string) ([]uint64, error)
+	LookupKeys(ctx context.Context, index, frame string, keys []string) ([]uint64, error)
+	TranslateIDs(ctx context.Context, index, frame string, ids []uint64) ([]string, error)
 }
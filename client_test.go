@@ -19,7 +19,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pilosa/pilosa"
@@ -201,6 +203,47 @@ func TestClient_MultiNode(t *testing.T) {
 	}
 }
 
+// Ensure the client forwards the context deadline to the remote node.
+func TestClient_ExecuteQuery_Timeout(t *testing.T) {
+	s := test.NewServer()
+	defer s.Close()
+	s.Handler.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		if deadline, ok := ctx.Deadline(); !ok {
+			t.Fatal("expected deadline")
+		} else if d := time.Until(deadline); d <= 0 || d > time.Minute {
+			t.Fatalf("unexpected deadline: %s", d)
+		}
+		return []interface{}{uint64(1)}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	c := test.MustNewClient(s.Host())
+	if resp, err := c.ExecuteQuery(ctx, "i", &internal.QueryRequest{Query: "Count(Bitmap(rowID=1))", Remote: true}); err != nil {
+		t.Fatal(err)
+	} else if resp.Results[0].N != 1 {
+		t.Fatalf("unexpected result: %d", resp.Results[0].N)
+	}
+
+	// Expired deadlines are not sent.
+	ctx, cancel = context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	if _, err := c.ExecuteQuery(ctx, "i", &internal.QueryRequest{Query: "Count(Bitmap(rowID=1))"}); err != context.DeadlineExceeded {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Deadlines exceeded on the remote node are returned as such.
+	s.Handler.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		return nil, context.DeadlineExceeded
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if _, err := c.ExecuteQuery(ctx, "i", &internal.QueryRequest{Query: "Count(Bitmap(rowID=1))", Remote: true}); err != context.DeadlineExceeded {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 // Ensure client can bulk import data.
 func TestClient_Import(t *testing.T) {
 	hldr := test.MustOpenHolder()
@@ -338,6 +381,77 @@ func TestClient_ImportValue(t *testing.T) {
 	}
 }
 
+// Ensure client can bulk import & export decimal value data.
+func TestClient_ImportValue_Decimal(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+
+	fld := pilosa.Field{
+		Name:  "price",
+		Type:  pilosa.FieldTypeDecimal,
+		Scale: 2,
+		Min:   -10000,
+		Max:   100000,
+	}
+
+	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	big := pilosa.Field{Name: "big", Type: pilosa.FieldTypeDecimal, Scale: 4, Min: 0, Max: 1 << 62}
+	frame, err := index.CreateFrameIfNotExists("f", pilosa.FrameOptions{RangeEnabled: true, Fields: []*pilosa.Field{&fld, &big}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := test.NewServer()
+	defer s.Close()
+	s.Handler.URI = s.HostURI()
+	s.Handler.Cluster = test.NewCluster(1)
+	s.Handler.Cluster.Nodes[0].Host = s.Host()
+	s.Handler.Holder = hldr.Holder
+
+	// Send import request with a mix of integer & decimal values.
+	c := test.MustNewClient(s.Host())
+	if err := c.ImportValue(context.Background(), "i", "f", fld.Name, 0, []pilosa.FieldValue{
+		{ColumnID: 1, DecimalValue: "-10.5"},
+		{ColumnID: 2, DecimalValue: "0.1"},
+		{ColumnID: 3, Value: 20},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify data is stored in scaled units.
+	if sum, cnt, err := frame.FieldSum(nil, fld.Name); err != nil {
+		t.Fatal(err)
+	} else if sum != 960 || cnt != 3 {
+		t.Fatalf("unexpected values: got sum=%v, count=%v; expected sum=960, cnt=3", sum, cnt)
+	}
+
+	// Values with more precision than the scale are rejected.
+	if err := c.ImportValue(context.Background(), "i", "f", fld.Name, 0, []pilosa.FieldValue{
+		{ColumnID: 4, DecimalValue: "1.125"},
+	}); err == nil || !strings.Contains(err.Error(), pilosa.ErrFieldValuePrecision.Error()) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Values which cannot be represented as a float64 are not rounded.
+	if err := c.ImportValue(context.Background(), "i", "f", big.Name, 0, []pilosa.FieldValue{
+		{ColumnID: 5, DecimalValue: "12345678901234.5678"},
+	}); err != nil {
+		t.Fatal(err)
+	} else if value, exists, err := frame.FieldValue(5, big.Name); err != nil {
+		t.Fatal(err)
+	} else if !exists || value != 123456789012345678 {
+		t.Fatalf("unexpected value: %d, exists=%v", value, exists)
+	}
+
+	// Export values as decimals.
+	var buf bytes.Buffer
+	if err := c.ExportCSV(context.Background(), "i", "f", pilosa.ViewFieldPrefix+fld.Name, 0, &buf); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != "1,-10.50\n2,0.10\n3,20.00\n" {
+		t.Fatalf("unexpected export: %q", buf.String())
+	}
+}
+
 // Ensure client backup and restore a frame.
 func TestClient_BackupRestore(t *testing.T) {
 	hldr := test.MustOpenHolder()
@@ -85,6 +85,14 @@ type Config struct {
 		Interval Duration `toml:"interval"`
 	} `toml:"anti-entropy"`
 
+	// Controls when fragment writes are flushed to disk: "none" leaves it to
+	// the operating system, "interval" syncs every FsyncInterval and
+	// "request" syncs before each write request returns.
+	Storage struct {
+		Fsync         string   `toml:"fsync"`
+		FsyncInterval Duration `toml:"fsync-interval"`
+	} `toml:"storage"`
+
 	// Limits the number of mutating commands that can be in a single request to
 	// the server. This includes SetBit, ClearBit, SetRowAttrs & SetColumnAttrs.
 	MaxWritesPerRequest int `toml:"max-writes-per-request"`
@@ -112,6 +120,8 @@ func NewConfig() *Config {
 	c.Cluster.PollInterval = Duration(DefaultPollingInterval)
 	c.Cluster.Hosts = []string{}
 	c.AntiEntropy.Interval = Duration(DefaultAntiEntropyInterval)
+	c.Storage.Fsync = FsyncNone
+	c.Storage.FsyncInterval = Duration(DefaultFsyncInterval)
 	c.Metric.Service = DefaultMetrics
 	c.Metric.Diagnostics = true
 	c.TLS = TLSConfig{}
@@ -124,6 +134,12 @@ func (c *Config) Validate() error {
 		return ErrConfigClusterTypeInvalid
 	}
 
+	if !StringInSlice(c.Storage.Fsync, FsyncPolicies) {
+		return ErrInvalidFsyncPolicy
+	} else if c.Storage.Fsync == FsyncInterval && c.Storage.FsyncInterval <= 0 {
+		return ErrInvalidFsyncInterval
+	}
+
 	if c.Cluster.Type == ClusterGossip {
 		if len(c.Cluster.Hosts) > 0 {
 			bindWithDefaults, err := AddressWithDefaults(c.Bind)
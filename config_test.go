@@ -29,6 +29,21 @@ func Test_NewConfig(t *testing.T) {
 	}
 
 	c.Bind = "localhost:10101"
+
+	// Check for an unknown fsync policy.
+	c.Storage.Fsync = "invalid"
+	if err := c.Validate(); err != pilosa.ErrInvalidFsyncPolicy {
+		t.Fatal(err)
+	}
+	c.Storage.Fsync = pilosa.FsyncInterval
+
+	// Check for a non-positive fsync interval.
+	c.Storage.FsyncInterval = 0
+	if err := c.Validate(); err != pilosa.ErrInvalidFsyncInterval {
+		t.Fatal(err)
+	}
+	c.Storage.FsyncInterval = pilosa.Duration(pilosa.DefaultFsyncInterval)
+
 	c.Cluster.ReplicaN = 2
 	c.GossipSeed = "localhost:14000"
 	if err := c.Validate(); err != nil {
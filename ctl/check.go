@@ -87,9 +87,15 @@ func (cmd *CheckCommand) checkBitmapFile(path string) error {
 	}
 	defer syscall.Munmap(data)
 
+	// Decompress the snapshot, if necessary.
+	buf, _, err := pilosa.DecodeFragmentData(data)
+	if err != nil {
+		return err
+	}
+
 	// Attach the mmap file to the bitmap.
 	bm := roaring.NewBitmap()
-	if err := bm.UnmarshalBinary(data); err != nil {
+	if err := bm.UnmarshalBinary(buf); err != nil {
 		return err
 	}
 
@@ -26,6 +26,8 @@ import (
 	"testing"
 
 	"context"
+
+	"github.com/pilosa/pilosa"
 )
 
 func TestCheckCommand_RunCacheFile(t *testing.T) {
@@ -91,6 +93,36 @@ func TestCheckCommand_Run(t *testing.T) {
 	//	Todo: need correct roaring file for happy path
 }
 
+func TestCheckCommand_Run_Compressed(t *testing.T) {
+	path := TempFileName("", "")
+	defer os.Remove(path)
+	defer os.Remove(path + pilosa.CacheExt)
+
+	// Write a fragment with a compressed snapshot followed by an op.
+	f := pilosa.NewFragment(path, "i", "f", pilosa.ViewStandard, 0)
+	f.Compression = pilosa.CompressionGzip
+	if err := f.Open(); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetBit(1, 2); err != nil {
+		t.Fatal(err)
+	} else if err := f.Snapshot(); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetBit(1, 3); err != nil {
+		t.Fatal(err)
+	} else if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	cm := NewCheckCommand(bytes.NewReader(nil), &buf, &buf)
+	cm.Paths = []string{path}
+	if err := cm.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if !strings.Contains(buf.String(), path+": ok") {
+		t.Fatalf("unexpected output: %s", buf.String())
+	}
+}
+
 // TempFileName generates a temporary filename with extension
 func TempFileName(prefix, suffix string) string {
 	randBytes := make([]byte, 16)
@@ -19,6 +19,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/pilosa/pilosa"
 )
@@ -57,7 +58,7 @@ func (cmd *ExportCommand) Run(ctx context.Context) error {
 		return pilosa.ErrIndexRequired
 	} else if cmd.Frame == "" {
 		return pilosa.ErrFrameRequired
-	} else if !(cmd.View == pilosa.ViewStandard || cmd.View == pilosa.ViewInverse) {
+	} else if !(cmd.View == pilosa.ViewStandard || cmd.View == pilosa.ViewInverse || strings.HasPrefix(cmd.View, pilosa.ViewFieldPrefix)) {
 		return pilosa.ErrInvalidView
 	}
 
@@ -82,10 +83,10 @@ func (cmd *ExportCommand) Run(ctx context.Context) error {
 
 	// Determine slice count.
 	var maxSlices map[string]uint64
-	if cmd.View == pilosa.ViewStandard {
-		maxSlices, err = client.MaxSliceByIndex(ctx)
-	} else if cmd.View == pilosa.ViewInverse {
+	if cmd.View == pilosa.ViewInverse {
 		maxSlices, err = client.MaxInverseSliceByIndex(ctx)
+	} else {
+		maxSlices, err = client.MaxSliceByIndex(ctx)
 	}
 
 	if err != nil {
@@ -52,6 +52,10 @@ max-writes-per-request = 5000
 [anti-entropy]
   interval = "10m0s"
 
+[storage]
+  fsync = "none"
+  fsync-interval = "1s"
+
 [profile]
   cpu = ""
   cpu-time = "30s"
@@ -292,12 +292,15 @@ func (cmd *ImportCommand) bufferFieldValues(ctx context.Context, path string) er
 		}
 		val.ColumnID = columnID
 
-		// Parse field value.
-		value, err := strconv.ParseInt(record[1], 10, 64)
-		if err != nil {
+		// Parse field value. Decimal values are sent as strings so they are
+		// scaled by the server without loss of precision.
+		if value, err := strconv.ParseInt(record[1], 10, 64); err == nil {
+			val.Value = value
+		} else if _, err := strconv.ParseFloat(record[1], 64); err == nil {
+			val.DecimalValue = record[1]
+		} else {
 			return fmt.Errorf("invalid value on row %d: %q", rnum, record[1])
 		}
-		val.Value = value
 
 		a = append(a, val)
 
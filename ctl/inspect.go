@@ -65,8 +65,17 @@ func (cmd *InspectCommand) Run(ctx context.Context) error {
 	}
 	defer syscall.Munmap(data)
 
-	// Attach the mmap file to the bitmap.
+	// Decompress the snapshot, if necessary.
 	t := time.Now()
+	fmt.Fprintf(cmd.Stderr, "decoding data file...")
+	data, compression, err := pilosa.DecodeFragmentData(data)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.Stderr, " (%s)\n", time.Since(t))
+
+	// Attach the mmap file to the bitmap.
+	t = time.Now()
 	fmt.Fprintf(cmd.Stderr, "unmarshaling bitmap...")
 	bm := roaring.NewBitmap()
 	if err := bm.UnmarshalBinary(data); err != nil {
@@ -82,6 +91,9 @@ func (cmd *InspectCommand) Run(ctx context.Context) error {
 
 	// Print top-level info.
 	fmt.Fprintf(cmd.Stdout, "== Bitmap Info ==\n")
+	if compression != pilosa.CompressionNone {
+		fmt.Fprintf(cmd.Stdout, "Compression: %s\n", compression)
+	}
 	fmt.Fprintf(cmd.Stdout, "Containers: %d\n", len(info.Containers))
 	fmt.Fprintf(cmd.Stdout, "Operations: %d\n", info.OpN)
 	fmt.Fprintln(cmd.Stdout, "")
@@ -17,6 +17,7 @@ package ctl
 import (
 	"time"
 
+	"github.com/pilosa/pilosa"
 	"github.com/pilosa/pilosa/server"
 	"github.com/spf13/cobra"
 )
@@ -38,6 +39,8 @@ func BuildServerFlags(cmd *cobra.Command, srv *server.Command) {
 	flags.DurationVarP((*time.Duration)(&srv.Config.Cluster.LongQueryTime), "cluster.long-query-time", "", time.Minute, "Duration that will trigger log and stat messages for slow queries.")
 	flags.StringVar(&srv.Config.LogPath, "log-path", "", "Log path")
 	flags.DurationVarP((*time.Duration)(&srv.Config.AntiEntropy.Interval), "anti-entropy.interval", "", time.Minute*10, "Interval at which to run anti-entropy routine.")
+	flags.StringVarP(&srv.Config.Storage.Fsync, "storage.fsync", "", pilosa.FsyncNone, "When fragment writes are synced to disk. Choose from [none, interval, request]")
+	flags.DurationVarP((*time.Duration)(&srv.Config.Storage.FsyncInterval), "storage.fsync-interval", "", pilosa.DefaultFsyncInterval, "Interval at which written fragments are synced with the interval fsync policy.")
 	flags.StringVarP(&srv.CPUProfile, "profile.cpu", "", "", "Where to store CPU profile.")
 	flags.DurationVarP(&srv.CPUTime, "profile.cpu-time", "", 30*time.Second, "CPU profile duration.")
 	flags.StringVarP(&srv.Config.Cluster.Type, "cluster.type", "", "gossip", "Determine how the cluster handles membership and state sharing. Choose from [static, gossip]")
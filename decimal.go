@@ -0,0 +1,98 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MaxFieldScale is the largest scale supported by decimal fields.
+// Scaled values must fit in an int64.
+const MaxFieldScale = 18
+
+// ParseDecimal parses a decimal string, such as "-12.50", into an integer
+// scaled by 10^scale. Returns ErrFieldValuePrecision if s has more fractional
+// digits than scale allows.
+func ParseDecimal(s string, scale uint32) (int64, error) {
+	if scale > MaxFieldScale {
+		return 0, ErrInvalidFieldScale
+	}
+
+	// Split sign, integer & fractional parts.
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return 0, errors.New("invalid decimal: empty")
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	// Drop insignificant trailing zeros before checking precision.
+	fracPart = strings.TrimRight(fracPart, "0")
+	if uint32(len(fracPart)) > scale {
+		return 0, ErrFieldValuePrecision
+	}
+	fracPart += strings.Repeat("0", int(scale)-len(fracPart))
+
+	// Parse digits as a single unsigned integer to detect overflow.
+	digits := strings.TrimLeft(intPart+fracPart, "0")
+	if digits == "" {
+		return 0, nil
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return 0, errors.New("invalid decimal: " + s)
+		}
+	}
+	u, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || u > math.MaxInt64 {
+		return 0, errors.New("decimal out of range: " + s)
+	}
+	if neg {
+		return -int64(u), nil
+	}
+	return int64(u), nil
+}
+
+// FormatDecimal formats v, an integer scaled by 10^scale, as a decimal string.
+func FormatDecimal(v int64, scale uint32) string {
+	if scale == 0 {
+		return strconv.FormatInt(v, 10)
+	}
+
+	// Format the absolute value using uint64 so that MinInt64 is handled.
+	neg := v < 0
+	u := uint64(v)
+	if neg {
+		u = uint64(-v)
+	}
+	s := strconv.FormatUint(u, 10)
+	if n := int(scale) + 1 - len(s); n > 0 {
+		s = strings.Repeat("0", n) + s
+	}
+	s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
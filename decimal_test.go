@@ -0,0 +1,80 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pilosa/pilosa"
+)
+
+// Ensure decimal strings can be parsed into scaled integers.
+func TestParseDecimal(t *testing.T) {
+	for _, tt := range []struct {
+		s     string
+		scale uint32
+		exp   int64
+		err   string
+	}{
+		{s: "0", scale: 2, exp: 0},
+		{s: "1.25", scale: 2, exp: 125},
+		{s: "1.2", scale: 2, exp: 120},
+		{s: "-1.25", scale: 2, exp: -125},
+		{s: "+.5", scale: 1, exp: 5},
+		{s: "10.", scale: 0, exp: 10},
+		{s: "1.2500", scale: 2, exp: 125},
+		{s: "123456789012345.678", scale: 3, exp: 123456789012345678},
+		{s: "9223372036854775807", scale: 0, exp: math.MaxInt64},
+		{s: "1.125", scale: 2, err: pilosa.ErrFieldValuePrecision.Error()},
+		{s: "9223372036854775808", scale: 0, err: "decimal out of range: 9223372036854775808"},
+		{s: "1x", scale: 0, err: "invalid decimal: 1x"},
+		{s: "", scale: 0, err: "invalid decimal: empty"},
+		{s: "1", scale: pilosa.MaxFieldScale + 1, err: pilosa.ErrInvalidFieldScale.Error()},
+	} {
+		v, err := pilosa.ParseDecimal(tt.s, tt.scale)
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err {
+				t.Fatalf("%q: unexpected error: %v", tt.s, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatalf("%q: %s", tt.s, err)
+		} else if v != tt.exp {
+			t.Fatalf("%q: unexpected value: %d", tt.s, v)
+		}
+	}
+}
+
+// Ensure scaled integers can be formatted as decimal strings.
+func TestFormatDecimal(t *testing.T) {
+	for _, tt := range []struct {
+		v     int64
+		scale uint32
+		exp   string
+	}{
+		{v: 0, scale: 0, exp: "0"},
+		{v: 0, scale: 2, exp: "0.00"},
+		{v: 125, scale: 2, exp: "1.25"},
+		{v: 5, scale: 3, exp: "0.005"},
+		{v: -125, scale: 2, exp: "-1.25"},
+		{v: -5, scale: 2, exp: "-0.05"},
+		{v: math.MinInt64, scale: 2, exp: "-92233720368547758.08"},
+	} {
+		if s := pilosa.FormatDecimal(tt.v, tt.scale); s != tt.exp {
+			t.Fatalf("%d/%d: unexpected string: %s", tt.v, tt.scale, s)
+		}
+	}
+}
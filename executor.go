@@ -16,9 +16,17 @@ package pilosa
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"runtime"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pilosa/pilosa/internal"
@@ -46,8 +54,15 @@ type Executor struct {
 	// Client used for remote requests.
 	client InternalClient
 
+	// Translates string keys to IDs for indexes & frames with keys enabled.
+	Translator *Translator
+
 	// Maximum number of SetBit() or ClearBit() commands per request.
 	MaxWritesPerRequest int
+
+	// Bound results shared by the requests of each query, by query ID.
+	bindingMu      sync.Mutex
+	bindingResults map[string]*bindingResults
 }
 
 // NewExecutor returns a new instance of Executor.
@@ -60,8 +75,26 @@ func NewExecutor(clientOptions *ClientOptions) *Executor {
 	}
 }
 
+// maxPageBatchSlices is the maximum number of slices fetched at once while
+// paginating bitmap results.
+const maxPageBatchSlices = 64
+
 // Execute executes a PQL query.
 func (e *Executor) Execute(ctx context.Context, index string, q *pql.Query, slices []uint64, opt *ExecOptions) ([]interface{}, error) {
+	results, err := e.execute(ctx, index, q, slices, opt)
+
+	// Flush writes to disk before acknowledging them, if required. This also
+	// flushes writes made by earlier calls when a later call fails. Syncing
+	// does nothing if no fragment has been written to.
+	if e.Holder.FsyncPolicy == FsyncRequest {
+		if serr := e.Holder.Sync(); serr != nil && err == nil {
+			return nil, serr
+		}
+	}
+	return results, err
+}
+
+func (e *Executor) execute(ctx context.Context, index string, q *pql.Query, slices []uint64, opt *ExecOptions) ([]interface{}, error) {
 	// Verify that an index is set.
 	if index == "" {
 		return nil, ErrIndexRequired
@@ -77,6 +110,35 @@ func (e *Executor) Execute(ctx context.Context, index string, q *pql.Query, slic
 		opt = &ExecOptions{}
 	}
 
+	// Evaluate bound calls once per slice and reuse them in later calls.
+	// Remote requests carry the query ID so each node shares its bound
+	// results between all requests for the query.
+	if len(q.Bindings) > 0 {
+		if opt.QueryID == "" {
+			id, err := newQueryID()
+			if err != nil {
+				return nil, err
+			}
+			o := *opt
+			o.QueryID = id
+			opt = &o
+			defer e.releaseBindingResults(id)
+		}
+		ctx = withBindingCache(ctx, newBindingCache(q.Bindings, e.retainBindingResults(opt.QueryID)))
+	}
+
+	// Share attribute lookups between the slices of the request.
+	ctx = withAttrLookupCache(ctx)
+
+	// Convert string keys to IDs. Remote calls have already been translated.
+	if !opt.Remote {
+		for _, call := range q.Calls {
+			if err := e.translateCall(ctx, index, call); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Don't bother calculating slices for query types that don't require it.
 	needsSlices := needsSlices(q.Calls)
 
@@ -87,7 +149,8 @@ func (e *Executor) Execute(ctx context.Context, index string, q *pql.Query, slic
 	columnLabel := DefaultColumnLabel
 
 	// If slices aren't specified, then include all of them.
-	if len(slices) == 0 {
+	allSlices := len(slices) == 0
+	if allSlices {
 		// Determine slices and inverseSlices for use in e.executeCall().
 		if needsSlices {
 			// Round up the number of slices.
@@ -142,15 +205,182 @@ func (e *Executor) Execute(ctx context.Context, index string, q *pql.Query, slic
 			}
 		}
 
-		v, err := e.executeCall(ctx, index, call, slices, opt)
+		// Row IDs returned by RowAttrs() can be beyond the index's slices.
+		callSlices := slices
+		if allSlices && needsSlices {
+			other, err := e.rowAttrsSlices(ctx, index, call, slices)
+			if err != nil {
+				return nil, err
+			}
+			callSlices = other
+		}
+
+		// Stop executing calls once the query is canceled or times out.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		prof := ProfileFromContext(ctx).Start(&Profile{Name: "call", Call: call.String(), Host: e.Host})
+		v, err := e.executeCall(WithProfile(ctx, prof), index, call, callSlices, opt)
 		if err != nil {
 			return nil, err
 		}
+		prof.Finish(v)
 		results = append(results, v)
 	}
+
+	// Convert IDs in the results back to keys for the original caller.
+	if !opt.Remote {
+		for i, call := range q.Calls {
+			if err := e.translateResult(ctx, index, call, results[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return results, nil
 }
 
+// translateCall replaces the "col" and "row" key arguments of c and its
+// children with the translated column & row IDs. IDs are only allocated
+// for keys passed to write calls; reads of unknown keys return an error.
+func (e *Executor) translateCall(ctx context.Context, index string, c *pql.Call) error {
+	if key, ok := c.Args["col"].(string); ok {
+		idx := e.Holder.Index(index)
+		if idx == nil {
+			return ErrIndexNotFound
+		} else if !idx.Keys() {
+			return ErrIndexKeysNotEnabled
+		}
+
+		id, err := e.translateKey(ctx, index, "", key, c.IsWrite())
+		if err != nil {
+			return err
+		}
+		delete(c.Args, "col")
+		c.Args[idx.ColumnLabel()] = id
+	}
+
+	if key, ok := c.Args["row"].(string); ok {
+		frame, _ := c.Args["frame"].(string)
+		if frame == "" {
+			frame = DefaultFrame
+		}
+		f := e.Holder.Frame(index, frame)
+		if f == nil {
+			return ErrFrameNotFound
+		} else if !f.Keys() {
+			return ErrFrameKeysNotEnabled
+		}
+
+		id, err := e.translateKey(ctx, index, frame, key, c.IsWrite())
+		if err != nil {
+			return err
+		}
+		delete(c.Args, "row")
+		c.Args[f.RowLabel()] = id
+	}
+
+	// Translate children & call arguments.
+	for _, child := range c.Children {
+		if err := e.translateCall(ctx, index, child); err != nil {
+			return err
+		}
+	}
+	for _, v := range c.Args {
+		if child, ok := v.(*pql.Call); ok {
+			if err := e.translateCall(ctx, index, child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// translateKey returns the ID for key. A new ID is allocated for an unknown
+// key if create is true, otherwise ErrTranslateKeyNotFound is returned.
+func (e *Executor) translateKey(ctx context.Context, index, frame, key string, create bool) (uint64, error) {
+	if e.Translator == nil {
+		return 0, ErrTranslatorNotConfigured
+	}
+
+	translate := e.Translator.LookupKeys
+	if create {
+		translate = e.Translator.TranslateKeys
+	}
+	ids, err := translate(ctx, index, frame, []string{key})
+	if err != nil {
+		return 0, err
+	} else if ids[0] == 0 {
+		return 0, ErrTranslateKeyNotFound
+	}
+	return ids[0], nil
+}
+
+// translateResult attaches keys to the IDs of a result, if the IDs refer to
+// an index or frame with keys enabled.
+func (e *Executor) translateResult(ctx context.Context, index string, c *pql.Call, result interface{}) error {
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return nil
+	}
+
+	// Determine the frame that IDs are translated through if they are rows.
+	// Bitmaps from inverse calls and non-inverse TopN() return rows.
+	frame, _ := c.Args["frame"].(string)
+	if frame == "" {
+		frame = DefaultFrame
+	}
+	f := idx.Frame(frame)
+
+	switch result := result.(type) {
+	case *Bitmap:
+		if result == nil {
+			return nil
+		}
+		if c.SupportsInverse() && f != nil && c.IsInverse(f.RowLabel(), idx.ColumnLabel()) {
+			if !f.Keys() {
+				return nil
+			}
+		} else if !idx.Keys() {
+			return nil
+		} else {
+			frame = ""
+		}
+
+		if e.Translator == nil {
+			return ErrTranslatorNotConfigured
+		}
+		keys, err := e.Translator.TranslateIDs(ctx, index, frame, result.Bits())
+		if err != nil {
+			return err
+		}
+		result.Keys = keys
+
+	case []Pair:
+		if inverse, _ := c.Args["inverse"].(bool); inverse {
+			if !idx.Keys() {
+				return nil
+			}
+			frame = ""
+		} else if f == nil || !f.Keys() {
+			return nil
+		}
+
+		if e.Translator == nil {
+			return ErrTranslatorNotConfigured
+		}
+		keys, err := e.Translator.TranslateIDs(ctx, index, frame, Pairs(result).Keys())
+		if err != nil {
+			return err
+		}
+		for i := range result {
+			result[i].Key = keys[i]
+		}
+	}
+	return nil
+}
+
 // executeCall executes a call.
 func (e *Executor) executeCall(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) (interface{}, error) {
 
@@ -163,8 +393,19 @@ func (e *Executor) executeCall(ctx context.Context, index string, c *pql.Call, s
 	case "Sum":
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeSum(ctx, index, c, slices, opt)
+	case "Min":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeMin(ctx, index, c, slices, opt)
+	case "Max":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeMax(ctx, index, c, slices, opt)
+	case "Percentile":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executePercentile(ctx, index, c, slices, opt)
 	case "ClearBit":
 		return e.executeClearBit(ctx, index, c, opt)
+	case "ClearRow":
+		return e.executeClearRow(ctx, index, c, slices, opt)
 	case "Count":
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeCount(ctx, index, c, slices, opt)
@@ -174,11 +415,16 @@ func (e *Executor) executeCall(ctx context.Context, index string, c *pql.Call, s
 		return nil, e.executeSetFieldValue(ctx, index, c, opt)
 	case "SetRowAttrs":
 		return nil, e.executeSetRowAttrs(ctx, index, c, opt)
+	case "Store":
+		return nil, e.executeStore(ctx, index, c, slices, opt)
 	case "SetColumnAttrs":
 		return nil, e.executeSetColumnAttrs(ctx, index, c, opt)
 	case "TopN":
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeTopN(ctx, index, c, slices, opt)
+	case "GroupBy":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeGroupBy(ctx, index, c, slices, opt)
 	default:
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeBitmapCall(ctx, index, c, slices, opt)
@@ -239,6 +485,174 @@ func (e *Executor) executeSum(ctx context.Context, index string, c *pql.Call, sl
 	return other, nil
 }
 
+// executeMin executes a Min() call.
+func (e *Executor) executeMin(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) (ValCount, error) {
+	if err := validateValCountArgs(c); err != nil {
+		return ValCount{}, err
+	}
+
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(slice uint64) (interface{}, error) {
+		return e.executeMinSlice(ctx, index, c, slice)
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.(ValCount)
+		return other.Smaller(v.(ValCount))
+	}
+
+	result, err := e.mapReduce(ctx, index, slices, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return ValCount{}, err
+	}
+	other, _ := result.(ValCount)
+
+	if other.Count == 0 {
+		return ValCount{}, nil
+	}
+	return other, nil
+}
+
+// executeMax executes a Max() call.
+func (e *Executor) executeMax(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) (ValCount, error) {
+	if err := validateValCountArgs(c); err != nil {
+		return ValCount{}, err
+	}
+
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(slice uint64) (interface{}, error) {
+		return e.executeMaxSlice(ctx, index, c, slice)
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.(ValCount)
+		return other.Larger(v.(ValCount))
+	}
+
+	result, err := e.mapReduce(ctx, index, slices, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return ValCount{}, err
+	}
+	other, _ := result.(ValCount)
+
+	if other.Count == 0 {
+		return ValCount{}, nil
+	}
+	return other, nil
+}
+
+// executePercentile executes a Percentile() call.
+//
+// The value is located by binary searching the field's range with distributed
+// Count(Range(...)) queries so that no column values leave their nodes.
+func (e *Executor) executePercentile(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) (ValCount, error) {
+	if err := validateValCountArgs(c); err != nil {
+		return ValCount{}, err
+	}
+	frame, _ := c.Args["frame"].(string)
+	field, _ := c.Args["field"].(string)
+
+	var nth float64
+	switch v := c.Args["nth"].(type) {
+	case int64:
+		nth = float64(v)
+	case float64:
+		nth = v
+	case nil:
+		return ValCount{}, errors.New("Percentile(): nth required")
+	default:
+		return ValCount{}, fmt.Errorf("Percentile(): invalid nth: %v", v)
+	}
+	if nth < 0 || nth > 100 {
+		return ValCount{}, errors.New("Percentile(): nth must be between 0 and 100")
+	}
+
+	// Determine the number of columns & the bounds of the search.
+	args := map[string]interface{}{"frame": frame, "field": field}
+	sum, err := e.executeSum(ctx, index, &pql.Call{Name: "Sum", Args: args, Children: c.Children}, slices, opt)
+	if err != nil {
+		return ValCount{}, err
+	} else if sum.Count == 0 {
+		return ValCount{}, nil
+	}
+	min, err := e.executeMin(ctx, index, &pql.Call{Name: "Min", Args: args, Children: c.Children}, slices, opt)
+	if err != nil {
+		return ValCount{}, err
+	}
+	max, err := e.executeMax(ctx, index, &pql.Call{Name: "Max", Args: args, Children: c.Children}, slices, opt)
+	if err != nil {
+		return ValCount{}, err
+	}
+
+	// Use the nearest-rank method to find the rank of the percentile value.
+	rank := int64(math.Ceil(nth / 100 * float64(sum.Count)))
+	if rank < 1 {
+		rank = 1
+	}
+
+	// Find the smallest value which has at least rank values at or below it.
+	lo, hi := min.Val, max.Val
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		n, err := e.executeFieldCount(ctx, index, c, frame, field, pql.LTE, conditionValue(mid, min.Scale), slices, opt)
+		if err != nil {
+			return ValCount{}, err
+		}
+		if int64(n) >= rank {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	n, err := e.executeFieldCount(ctx, index, c, frame, field, pql.EQ, conditionValue(lo, min.Scale), slices, opt)
+	if err != nil {
+		return ValCount{}, err
+	}
+	return ValCount{Val: lo, Count: int64(n), Scale: min.Scale}, nil
+}
+
+// conditionValue returns a condition value for v, a value in a field's
+// scaled units. Decimal values are passed as strings to preserve precision.
+func conditionValue(v int64, scale uint32) interface{} {
+	if scale == 0 {
+		return v
+	}
+	return FormatDecimal(v, scale)
+}
+
+// executeFieldCount returns the number of columns matching a field condition,
+// intersected with the optional bitmap input of c.
+func (e *Executor) executeFieldCount(ctx context.Context, index string, c *pql.Call, frame, field string, op pql.Token, value interface{}, slices []uint64, opt *ExecOptions) (uint64, error) {
+	child := &pql.Call{
+		Name: "Range",
+		Args: map[string]interface{}{
+			"frame": frame,
+			field:   &pql.Condition{Op: op, Value: value},
+		},
+	}
+	if len(c.Children) == 1 {
+		child = &pql.Call{Name: "Intersect", Children: []*pql.Call{child, c.Children[0]}}
+	}
+	return e.executeCount(ctx, index, &pql.Call{Name: "Count", Children: []*pql.Call{child}}, slices, opt)
+}
+
+// validateValCountArgs ensures that a Min(), Max() or Percentile() call has the required arguments.
+func validateValCountArgs(c *pql.Call) error {
+	if frame, _ := c.Args["frame"].(string); frame == "" {
+		return fmt.Errorf("%s(): frame required", c.Name)
+	} else if field, _ := c.Args["field"].(string); field == "" {
+		return fmt.Errorf("%s(): field required", c.Name)
+	}
+
+	if len(c.Children) > 1 {
+		return fmt.Errorf("%s() only accepts a single bitmap input", c.Name)
+	}
+	return nil
+}
+
 // executeBitmapCall executes a call that returns a bitmap.
 func (e *Executor) executeBitmapCall(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) (*Bitmap, error) {
 	// Execute calls in bulk on each remote node and merge.
@@ -256,9 +670,91 @@ func (e *Executor) executeBitmapCall(ctx context.Context, index string, c *pql.C
 		return other
 	}
 
-	other, err := e.mapReduce(ctx, index, slices, c, opt, mapFn, reduceFn)
-	if err != nil {
-		return nil, err
+	// Only return a page of bits, if requested. Slices are visited in order
+	// so that the whole result is never merged on the coordinating node.
+	var other interface{}
+	if opt.paginated() && !opt.Remote {
+		start, err := decodeCursor(opt.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		ordered := append([]uint64(nil), slices...)
+		sort.Sort(uint64Slice(ordered))
+
+		var pending []uint64
+		for _, slice := range ordered {
+			if (slice+1)*SliceWidth > start {
+				pending = append(pending, slice)
+			}
+		}
+
+		// Fetch slices in batches which double in size so that a small page
+		// only reads a few slices while sparse results & large offsets don't
+		// need a request per slice. Each batch is sent to a node at once.
+		page := NewBitmap()
+		offset := opt.Offset
+		for batchN := 1; len(pending) > 0 && page.Cursor == ""; {
+			if batchN > len(pending) {
+				batchN = len(pending)
+			}
+			batch := pending[:batchN]
+			pending = pending[batchN:]
+			if batchN < maxPageBatchSlices {
+				batchN *= 2
+			}
+
+			v, err := e.mapReduce(ctx, index, batch, c, opt, mapFn, reduceFn)
+			if err != nil {
+				return nil, err
+			}
+			bm, _ := v.(*Bitmap)
+			if bm == nil {
+				continue
+			}
+
+			// Visit the batch's slices in order.
+			for _, slice := range batch {
+				seg := bm.segment(slice)
+				if seg == nil {
+					continue
+				}
+
+				// Skip the entire segment if it is within the offset.
+				if start <= slice*SliceWidth {
+					if n := seg.data.Count(); offset >= n {
+						offset -= n
+						continue
+					}
+				}
+
+				itr := seg.data.Iterator()
+				itr.Seek(start)
+				for id, eof := itr.Next(); !eof; id, eof = itr.Next() {
+					if offset > 0 {
+						offset--
+						continue
+					}
+					page.SetBit(id)
+
+					// Return a cursor to the next column once the page is full.
+					if opt.Limit > 0 && page.Count() >= opt.Limit {
+						page.Cursor = encodeCursor(id + 1)
+						break
+					}
+				}
+				if page.Cursor != "" {
+					break
+				}
+			}
+		}
+		other = page
+	} else {
+		v, err := e.mapReduce(ctx, index, slices, c, opt, mapFn, reduceFn)
+		if err != nil {
+			return nil, err
+		}
+		other = v
 	}
 
 	// Attach attributes for Bitmap() calls.
@@ -277,7 +773,7 @@ func (e *Executor) executeBitmapCall(ctx context.Context, index string, c *pql.C
 					if err != nil {
 						return nil, err
 					}
-					bm.Attrs = attrs
+					bm.Attrs = filterAttrs(attrs, opt.AttrKeys)
 				} else if err != nil {
 					return nil, err
 				} else {
@@ -292,7 +788,7 @@ func (e *Executor) executeBitmapCall(ctx context.Context, index string, c *pql.C
 						if err != nil {
 							return nil, err
 						}
-						bm.Attrs = attrs
+						bm.Attrs = filterAttrs(attrs, opt.AttrKeys)
 					}
 				}
 			}
@@ -307,16 +803,40 @@ func (e *Executor) executeBitmapCall(ctx context.Context, index string, c *pql.C
 }
 
 // executeBitmapCallSlice executes a bitmap call for a single slice.
+// Bound calls are only executed once per slice for each query.
 func (e *Executor) executeBitmapCallSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
+	if cache := bindingCacheFromContext(ctx); cache != nil && cache.bound(c) {
+		return cache.fetch(c, slice, func() (*Bitmap, error) {
+			bm, err := e.executeUncachedBitmapCallSlice(ctx, index, c, slice)
+			if err != nil {
+				return nil, err
+			}
+			// Rows can be shared with the fragment's row cache, which is
+			// updated by later writes, so keep a copy.
+			return bm.Clone(), nil
+		})
+	}
+	return e.executeUncachedBitmapCallSlice(ctx, index, c, slice)
+}
+
+// executeUncachedBitmapCallSlice executes a bitmap call for a single slice
+// without checking for a bound result.
+func (e *Executor) executeUncachedBitmapCallSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
 	switch c.Name {
 	case "Bitmap":
 		return e.executeBitmapSlice(ctx, index, c, slice)
+	case "ColumnAttrs":
+		return e.executeColumnAttrsSlice(ctx, index, c, slice)
 	case "Difference":
 		return e.executeDifferenceSlice(ctx, index, c, slice)
 	case "Intersect":
 		return e.executeIntersectSlice(ctx, index, c, slice)
+	case "Not":
+		return e.executeNotSlice(ctx, index, c, slice)
 	case "Range":
 		return e.executeRangeSlice(ctx, index, c, slice)
+	case "RowAttrs":
+		return e.executeRowAttrsSlice(ctx, index, c, slice)
 	case "Union":
 		return e.executeUnionSlice(ctx, index, c, slice)
 	case "Xor":
@@ -345,181 +865,682 @@ func (e *Executor) executeSumCountSlice(ctx context.Context, index string, c *pq
 		return SumCount{}, nil
 	}
 
-	field := frame.Field(fieldName)
-	if field == nil {
-		return SumCount{}, nil
+	field, frag, release := frame.fieldFragment(fieldName, slice)
+	defer release()
+	if field == nil || frag == nil {
+		return SumCount{}, nil
+	}
+
+	vsum, vcount, err := frag.FieldSum(filter, field.BitDepth())
+	if err != nil {
+		return SumCount{}, err
+	}
+	return SumCount{
+		Sum:   int64(vsum) + (int64(vcount) * field.Min),
+		Count: int64(vcount),
+		Scale: field.Scale,
+	}, nil
+}
+
+// executeMinSlice calculates the min & count for fields on a slice.
+func (e *Executor) executeMinSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (ValCount, error) {
+	filter, field, frag, release, err := e.valCountSliceArgs(ctx, index, c, slice)
+	if err != nil {
+		return ValCount{}, err
+	}
+	defer release()
+	if frag == nil {
+		return ValCount{}, nil
+	}
+
+	min, count, err := frag.FieldMin(filter, field.BitDepth())
+	if err != nil {
+		return ValCount{}, err
+	}
+	return ValCount{
+		Val:   int64(min) + field.Min,
+		Count: int64(count),
+		Scale: field.Scale,
+	}, nil
+}
+
+// executeMaxSlice calculates the max & count for fields on a slice.
+func (e *Executor) executeMaxSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (ValCount, error) {
+	filter, field, frag, release, err := e.valCountSliceArgs(ctx, index, c, slice)
+	if err != nil {
+		return ValCount{}, err
+	}
+	defer release()
+	if frag == nil {
+		return ValCount{}, nil
+	}
+
+	max, count, err := frag.FieldMax(filter, field.BitDepth())
+	if err != nil {
+		return ValCount{}, err
+	}
+	return ValCount{
+		Val:   int64(max) + field.Min,
+		Count: int64(count),
+		Scale: field.Scale,
+	}, nil
+}
+
+// valCountSliceArgs returns the optional filter, the field and the field's
+// fragment for a Min() or Max() call on a slice. Returns a nil fragment if
+// the frame, field or fragment does not exist. Unless an error is returned,
+// release must be called once the fragment is no longer used.
+func (e *Executor) valCountSliceArgs(ctx context.Context, index string, c *pql.Call, slice uint64) (filter *Bitmap, field *Field, frag *Fragment, release func(), err error) {
+	if len(c.Children) == 1 {
+		if filter, err = e.executeBitmapCallSlice(ctx, index, c.Children[0], slice); err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
+	frameName, _ := c.Args["frame"].(string)
+	fieldName, _ := c.Args["field"].(string)
+
+	frame := e.Holder.Frame(index, frameName)
+	if frame == nil {
+		return nil, nil, nil, func() {}, nil
+	}
+
+	field, frag, release = frame.fieldFragment(fieldName, slice)
+	if field == nil || frag == nil {
+		return nil, nil, nil, release, nil
+	}
+	return filter, field, frag, release, nil
+}
+
+// executeTopN executes a TopN() call.
+// This first performs the TopN() to determine the top results and then
+// requeries to retrieve the full counts for each of the top results.
+func (e *Executor) executeTopN(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) ([]Pair, error) {
+	idsArg, _, err := c.UintSliceArg("ids")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopN: %v", err)
+	}
+	n, _, err := c.UintArg("n")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopN: %v", err)
+	}
+
+	// Execute original query.
+	pairs, err := e.executeTopNSlices(ctx, index, c, slices, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// If this call is against specific ids, or we didn't get results,
+	// or we are part of a larger distributed query then don't refetch.
+	if len(pairs) == 0 || len(idsArg) > 0 || opt.Remote {
+		return pairs, nil
+	}
+	// Only the original caller should refetch the full counts.
+	other := c.Clone()
+
+	ids := Pairs(pairs).Keys()
+	sort.Sort(uint64Slice(ids))
+	other.Args["ids"] = ids
+
+	trimmedList, err := e.executeTopNSlices(ctx, index, other, slices, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if n != 0 && int(n) < len(trimmedList) {
+		trimmedList = trimmedList[0:n]
+	}
+	return trimmedList, nil
+}
+
+func (e *Executor) executeTopNSlices(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) ([]Pair, error) {
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(slice uint64) (interface{}, error) {
+		return e.executeTopNSlice(ctx, index, c, slice)
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.([]Pair)
+		return Pairs(other).Add(v.([]Pair))
+	}
+
+	other, err := e.mapReduce(ctx, index, slices, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return nil, err
+	}
+	results, _ := other.([]Pair)
+
+	// Sort final merged results.
+	sort.Sort(Pairs(results))
+
+	return results, nil
+}
+
+// executeTopNSlice executes a TopN call for a single slice.
+func (e *Executor) executeTopNSlice(ctx context.Context, index string, c *pql.Call, slice uint64) ([]Pair, error) {
+	frame, _ := c.Args["frame"].(string)
+	inverse, _ := c.Args["inverse"].(bool)
+	n, _, err := c.UintArg("n")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopNSlice: %v", err)
+	}
+	field, _ := c.Args["field"].(string)
+	rowIDs, _, err := c.UintSliceArg("ids")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopNSlice: %v", err)
+	}
+	minThreshold, _, err := c.UintArg("threshold")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopNSlice: %v", err)
+	}
+	filters, _ := c.Args["filters"].([]interface{})
+	tanimotoThreshold, _, err := c.UintArg("tanimotoThreshold")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopNSlice: %v", err)
+	}
+
+	// Parse attribute filter expression.
+	var attrFilter AttrFilter
+	if v, ok := c.Args["where"]; ok {
+		call, ok := v.(*pql.Call)
+		if !ok {
+			return nil, errors.New("TopN(): where must be an attribute filter call")
+		}
+		if attrFilter, err = ParseAttrFilter(call); err != nil {
+			return nil, fmt.Errorf("TopN(): %s", err)
+		}
+	}
+
+	// Retrieve bitmap used to intersect.
+	var src *Bitmap
+	if len(c.Children) == 1 {
+		bm, err := e.executeBitmapCallSlice(ctx, index, c.Children[0], slice)
+		if err != nil {
+			return nil, err
+		}
+		src = bm
+	} else if len(c.Children) > 1 {
+		return nil, errors.New("TopN() can only have one input bitmap")
+	}
+
+	// Set default frame.
+	if frame == "" {
+		frame = DefaultFrame
+	}
+
+	// Determine view.
+	view := ViewStandard
+	if inverse {
+		view = ViewInverse
+	}
+
+	fr := e.Holder.Frame(index, frame)
+	if fr == nil {
+		return nil, nil
+	}
+	views, err := topNViews(fr, view, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if minThreshold <= 0 {
+		minThreshold = MinThreshold
+	}
+
+	if tanimotoThreshold > 100 {
+		return nil, errors.New("Tanimoto Threshold is from 1 to 100 only")
+	}
+	opt := TopOptions{
+		N:                 int(n),
+		Src:               src,
+		RowIDs:            rowIDs,
+		FilterField:       field,
+		FilterValues:      filters,
+		MinThreshold:      minThreshold,
+		TanimotoThreshold: tanimotoThreshold,
+		AttrFilter:        attrFilter,
+	}
+
+	// Rank a single view using its cache.
+	if len(views) == 1 {
+		f := e.Holder.Fragment(index, frame, views[0], slice)
+		if f == nil {
+			return nil, nil
+		}
+		return f.Top(opt)
+	}
+
+	// Gather candidate rows from every view covering the time range. Views
+	// are not cut to n since a row may only rank highly across all views.
+	var frags []*Fragment
+	for _, view := range views {
+		if f := e.Holder.Fragment(index, frame, view, slice); f != nil {
+			frags = append(frags, f)
+		}
+	}
+	candidates := rowIDs
+	if len(candidates) == 0 {
+		candidateOpt := opt
+		candidateOpt.N = 0
+		candidateOpt.MinThreshold = 1
+		candidateOpt.TanimotoThreshold = 0
+
+		m := make(map[uint64]struct{})
+		for _, f := range frags {
+			other, err := f.Top(candidateOpt)
+			if err != nil {
+				return nil, err
+			}
+			for _, pair := range other {
+				m[pair.ID] = struct{}{}
+			}
+		}
+		for id := range m {
+			candidates = append(candidates, id)
+		}
+		sort.Sort(uint64Slice(candidates))
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	// Count every candidate in every view so partial counts are kept.
+	// Thresholds apply to the total count rather than to each view.
+	countOpt := opt
+	countOpt.N = 0
+	countOpt.RowIDs = candidates
+	countOpt.MinThreshold = 1
+	countOpt.TanimotoThreshold = 0
+
+	var pairs []Pair
+	for _, f := range frags {
+		other, err := f.Top(countOpt)
+		if err != nil {
+			return nil, err
+		}
+		pairs = Pairs(pairs).Add(other)
+	}
+
+	// The Tanimoto coefficient also requires the total count of each row.
+	var srcCount uint64
+	var totals map[uint64]uint64
+	if tanimotoThreshold > 0 && src != nil {
+		srcCount = src.Count()
+		totalOpt := countOpt
+		totalOpt.Src = nil
+
+		totals = make(map[uint64]uint64, len(candidates))
+		for _, f := range frags {
+			other, err := f.Top(totalOpt)
+			if err != nil {
+				return nil, err
+			}
+			for _, pair := range other {
+				totals[pair.ID] += pair.Count
+			}
+		}
+	}
+
+	// Drop rows below the threshold, then rank & cut to n.
+	filtered := pairs[:0]
+	for _, pair := range pairs {
+		if totals != nil {
+			tanimoto := math.Ceil(float64(pair.Count*100) / float64(totals[pair.ID]+srcCount-pair.Count))
+			if tanimoto <= float64(tanimotoThreshold) {
+				continue
+			}
+		} else if pair.Count < minThreshold {
+			continue
+		}
+		filtered = append(filtered, pair)
+	}
+	pairs = filtered
+	sort.Sort(Pairs(pairs))
+
+	if n != 0 && int(n) < len(pairs) {
+		pairs = pairs[:n]
+	}
+	return pairs, nil
+}
+
+// topNViews returns the views ranked by a TopN() call. If the call specifies
+// a time range then the time quantum views covering the range are returned.
+func topNViews(f *Frame, view string, c *pql.Call) ([]string, error) {
+	_, hasStart := c.Args["start"]
+	_, hasEnd := c.Args["end"]
+	if !hasStart && !hasEnd {
+		return []string{view}, nil
+	}
+
+	// Parse start time.
+	startTimeStr, ok := c.Args["start"].(string)
+	if !ok {
+		return nil, errors.New("TopN() start time required")
+	}
+	startTime, err := time.Parse(TimeFormat, startTimeStr)
+	if err != nil {
+		return nil, errors.New("cannot parse TopN() start time")
+	}
+
+	// Parse end time.
+	endTimeStr, ok := c.Args["end"].(string)
+	if !ok {
+		return nil, errors.New("TopN() end time required")
+	}
+	endTime, err := time.Parse(TimeFormat, endTimeStr)
+	if err != nil {
+		return nil, errors.New("cannot parse TopN() end time")
+	}
+
+	// No views are ranked if the frame has no time quantum.
+	q := f.TimeQuantum()
+	if q == "" {
+		return []string{}, nil
+	}
+	return ViewsByTimeRange(view, startTime, endTime, q), nil
+}
+
+// executeGroupBy executes a GroupBy() call.
+func (e *Executor) executeGroupBy(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) ([]GroupCount, error) {
+	if len(c.Children) == 0 {
+		return nil, errors.New("GroupBy() requires at least one Rows() input")
+	}
+	for _, child := range c.Children {
+		if child.Name != "Rows" {
+			return nil, fmt.Errorf("GroupBy() only accepts Rows() inputs, found %s()", child.Name)
+		}
+		if frame, _ := child.Args["frame"].(string); frame == "" {
+			return nil, errors.New("Rows(): frame required")
+		}
+	}
+	if filter, ok := c.Args["filter"]; ok {
+		if _, ok := filter.(*pql.Call); !ok {
+			return nil, errors.New("GroupBy(): filter must be a bitmap call")
+		}
+	}
+
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(slice uint64) (interface{}, error) {
+		return e.executeGroupBySlice(ctx, index, c, slice)
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.([]GroupCount)
+		return GroupCounts(other).Add(v.([]GroupCount))
+	}
+
+	other, err := e.mapReduce(ctx, index, slices, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return nil, err
+	}
+	results, _ := other.([]GroupCount)
+
+	// Sort final merged results.
+	sort.Sort(GroupCounts(results))
+
+	return results, nil
+}
+
+// executeGroupBySlice executes a GroupBy call for a single slice.
+func (e *Executor) executeGroupBySlice(ctx context.Context, index string, c *pql.Call, slice uint64) ([]GroupCount, error) {
+	// Retrieve bitmap used to filter all groups.
+	var filter *Bitmap
+	if call, ok := c.Args["filter"].(*pql.Call); ok {
+		bm, err := e.executeBitmapCallSlice(ctx, index, call, slice)
+		if err != nil {
+			return nil, err
+		}
+		filter = bm
+	}
+
+	// Retrieve the fragment & rows for each input.
+	frags := make([]*Fragment, len(c.Children))
+	rows := make([][]uint64, len(c.Children))
+	for i, child := range c.Children {
+		frame, _ := child.Args["frame"].(string)
+		frag := e.Holder.Fragment(index, frame, ViewStandard, slice)
+		if frag == nil {
+			return nil, nil
+		}
+		frags[i], rows[i] = frag, frag.Rows()
+	}
+
+	// Walk each combination of rows, skipping any group that is already empty.
+	var results []GroupCount
+	group := make([]uint64, len(c.Children))
+	var walk func(depth int, src *Bitmap)
+	walk = func(depth int, src *Bitmap) {
+		for _, rowID := range rows[depth] {
+			bm := frags[depth].Row(rowID)
+			if src != nil {
+				bm = src.Intersect(bm)
+			}
+			n := bm.Count()
+			if n == 0 {
+				continue
+			}
+
+			group[depth] = rowID
+			if depth < len(rows)-1 {
+				walk(depth+1, bm)
+				continue
+			}
+			results = append(results, GroupCount{
+				Group: append([]uint64(nil), group...),
+				Count: n,
+			})
+		}
+	}
+	walk(0, filter)
+
+	return results, nil
+}
+
+// executeDifferenceSlice executes a difference() call for a local slice.
+func (e *Executor) executeDifferenceSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
+	var other *Bitmap
+	if len(c.Children) == 0 {
+		return nil, fmt.Errorf("empty Difference query is currently not supported")
+	}
+	for i, input := range c.Children {
+		bm, err := e.executeBitmapCallSlice(ctx, index, input, slice)
+		if err != nil {
+			return nil, err
+		}
+
+		// The first input may be shared so it's copied by the first
+		// difference. The copy is then modified in place.
+		switch i {
+		case 0:
+			other = bm
+		case 1:
+			other = other.Difference(bm)
+		default:
+			other.DifferenceInPlace(bm)
+		}
+	}
+	other.InvalidateCount()
+	return other, nil
+}
+
+// executeNotSlice executes a Not() call for a local slice.
+// Returns all existing columns in the index which are not set in the input bitmap.
+func (e *Executor) executeNotSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
+	if len(c.Children) == 0 {
+		return nil, errors.New("Not() requires an input bitmap")
+	} else if len(c.Children) > 1 {
+		return nil, errors.New("Not() only accepts a single bitmap input")
+	}
+
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return nil, ErrIndexNotFound
 	}
 
-	view := e.Holder.Fragment(index, frameName, ViewFieldPrefix+fieldName, slice)
-	if view == nil {
-		return SumCount{}, nil
+	// Retrieve all existing columns for the slice.
+	existence := NewBitmap()
+	if frag := idx.ExistenceView().Fragment(slice); frag != nil {
+		existence = frag.Row(0)
 	}
 
-	vsum, vcount, err := view.FieldSum(filter, field.BitDepth())
+	bm, err := e.executeBitmapCallSlice(ctx, index, c.Children[0], slice)
 	if err != nil {
-		return SumCount{}, err
+		return nil, err
 	}
-	return SumCount{
-		Sum:   int64(vsum) + (int64(vcount) * field.Min),
-		Count: int64(vcount),
-	}, nil
+
+	other := existence.Difference(bm)
+	other.InvalidateCount()
+	return other, nil
 }
 
-// executeTopN executes a TopN() call.
-// This first performs the TopN() to determine the top results and then
-// requeries to retrieve the full counts for each of the top results.
-func (e *Executor) executeTopN(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) ([]Pair, error) {
-	idsArg, _, err := c.UintSliceArg("ids")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopN: %v", err)
-	}
-	n, _, err := c.UintArg("n")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopN: %v", err)
+// executeColumnAttrsSlice returns the columns in a slice whose attributes
+// match every argument.
+func (e *Executor) executeColumnAttrsSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return nil, ErrIndexNotFound
 	}
+	return attrLookupSlice(ctx, idx.ColumnAttrStore(), c, c.Keys(), slice)
+}
 
-	// Execute original query.
-	pairs, err := e.executeTopNSlices(ctx, index, c, slices, opt)
+// executeRowAttrsSlice returns the row ids in a slice whose attributes match
+// every argument other than the frame.
+func (e *Executor) executeRowAttrsSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
+	store, keys, err := e.rowAttrsLookupArgs(index, c)
 	if err != nil {
 		return nil, err
 	}
+	return attrLookupSlice(ctx, store, c, keys, slice)
+}
 
-	// If this call is against specific ids, or we didn't get results,
-	// or we are part of a larger distributed query then don't refetch.
-	if len(pairs) == 0 || len(idsArg) > 0 || opt.Remote {
-		return pairs, nil
+// rowAttrsLookupArgs returns the attribute store & keys to look up for a
+// RowAttrs() call.
+func (e *Executor) rowAttrsLookupArgs(index string, c *pql.Call) (*AttrStore, []string, error) {
+	frame, _ := c.Args["frame"].(string)
+	if frame == "" {
+		frame = DefaultFrame
 	}
-	// Only the original caller should refetch the full counts.
-	other := c.Clone()
-
-	ids := Pairs(pairs).Keys()
-	sort.Sort(uint64Slice(ids))
-	other.Args["ids"] = ids
-
-	trimmedList, err := e.executeTopNSlices(ctx, index, other, slices, opt)
-	if err != nil {
-		return nil, err
+	f := e.Holder.Frame(index, frame)
+	if f == nil {
+		return nil, nil, ErrFrameNotFound
 	}
 
-	if n != 0 && int(n) < len(trimmedList) {
-		trimmedList = trimmedList[0:n]
+	var keys []string
+	for _, key := range c.Keys() {
+		if key != "frame" {
+			keys = append(keys, key)
+		}
 	}
-	return trimmedList, nil
+	return f.RowAttrStore(), keys, nil
 }
 
-func (e *Executor) executeTopNSlices(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) ([]Pair, error) {
-	// Execute calls in bulk on each remote node and merge.
-	mapFn := func(slice uint64) (interface{}, error) {
-		return e.executeTopNSlice(ctx, index, c, slice)
-	}
+// rowAttrsSlices returns slices extended with every slice that holds a row
+// ID matched by a RowAttrs() call within c. Row IDs are not bounded by the
+// index's column slices so they are looked up once here, on the coordinating
+// node, before the call is mapped across slices. The slices must be
+// contiguous from zero.
+func (e *Executor) rowAttrsSlices(ctx context.Context, index string, c *pql.Call, slices []uint64) ([]uint64, error) {
+	if c.Name == "RowAttrs" {
+		store, keys, err := e.rowAttrsLookupArgs(index, c)
+		if err != nil {
+			return nil, err
+		}
+		m, err := attrLookupSlices(ctx, store, c, keys)
+		if err != nil {
+			return nil, err
+		}
 
-	// Merge returned results at coordinating node.
-	reduceFn := func(prev, v interface{}) interface{} {
-		other, _ := prev.([]Pair)
-		return Pairs(other).Add(v.([]Pair))
+		for slice := range m {
+			for next := uint64(len(slices)); next <= slice; next++ {
+				slices = append(slices[:len(slices):len(slices)], next)
+			}
+		}
 	}
 
-	other, err := e.mapReduce(ctx, index, slices, c, opt, mapFn, reduceFn)
-	if err != nil {
-		return nil, err
+	for _, child := range c.Children {
+		other, err := e.rowAttrsSlices(ctx, index, child, slices)
+		if err != nil {
+			return nil, err
+		}
+		slices = other
 	}
-	results, _ := other.([]Pair)
-
-	// Sort final merged results.
-	sort.Sort(Pairs(results))
-
-	return results, nil
+	return slices, nil
 }
 
-// executeTopNSlice executes a TopN call for a single slice.
-func (e *Executor) executeTopNSlice(ctx context.Context, index string, c *pql.Call, slice uint64) ([]Pair, error) {
-	frame, _ := c.Args["frame"].(string)
-	inverse, _ := c.Args["inverse"].(bool)
-	n, _, err := c.UintArg("n")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopNSlice: %v", err)
-	}
-	field, _ := c.Args["field"].(string)
-	rowIDs, _, err := c.UintSliceArg("ids")
+// attrLookupSlice returns a bitmap of ids within a slice where the attribute
+// for each key equals the call's argument.
+func attrLookupSlice(ctx context.Context, store *AttrStore, c *pql.Call, keys []string, slice uint64) (*Bitmap, error) {
+	m, err := attrLookupSlices(ctx, store, c, keys)
 	if err != nil {
-		return nil, fmt.Errorf("executeTopNSlice: %v", err)
-	}
-	minThreshold, _, err := c.UintArg("threshold")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopNSlice: %v", err)
-	}
-	filters, _ := c.Args["filters"].([]interface{})
-	tanimotoThreshold, _, err := c.UintArg("tanimotoThreshold")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopNSlice: %v", err)
+		return nil, err
 	}
+	return NewBitmap(m[slice]...), nil
+}
 
-	// Retrieve bitmap used to intersect.
-	var src *Bitmap
-	if len(c.Children) == 1 {
-		bm, err := e.executeBitmapCallSlice(ctx, index, c.Children[0], slice)
+// attrLookupSlices returns the ids where the attribute for each key equals
+// the call's argument, grouped by slice. The lookup is shared by every slice
+// of the request if ctx has an attribute lookup cache.
+func attrLookupSlices(ctx context.Context, store *AttrStore, c *pql.Call, keys []string) (map[uint64][]uint64, error) {
+	lookup := func() (map[uint64][]uint64, error) {
+		ids, err := attrLookup(store, c, keys)
 		if err != nil {
 			return nil, err
 		}
-		src = bm
-	} else if len(c.Children) > 1 {
-		return nil, errors.New("TopN() can only have one input bitmap")
-	}
 
-	// Set default frame.
-	if frame == "" {
-		frame = DefaultFrame
+		m := make(map[uint64][]uint64)
+		for _, id := range ids {
+			m[id/SliceWidth] = append(m[id/SliceWidth], id)
+		}
+		return m, nil
 	}
 
-	// Determine view.
-	view := ViewStandard
-	if inverse {
-		view = ViewInverse
+	cache := attrLookupCacheFromContext(ctx)
+	if cache == nil {
+		return lookup()
 	}
 
-	f := e.Holder.Fragment(index, frame, view, slice)
-	if f == nil {
-		return nil, nil
+	cache.mu.Lock()
+	entry := cache.entries[c]
+	if entry == nil {
+		entry = &attrLookupCacheEntry{}
+		cache.entries[c] = entry
 	}
+	cache.mu.Unlock()
 
-	if minThreshold <= 0 {
-		minThreshold = MinThreshold
-	}
+	entry.once.Do(func() { entry.slices, entry.err = lookup() })
+	return entry.slices, entry.err
+}
 
-	if tanimotoThreshold > 100 {
-		return nil, errors.New("Tanimoto Threshold is from 1 to 100 only")
+// attrLookup returns the sorted ids where the attribute for each key equals
+// the call's argument.
+func attrLookup(store *AttrStore, c *pql.Call, keys []string) ([]uint64, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%s(): attribute required", c.Name)
 	}
-	return f.Top(TopOptions{
-		N:                 int(n),
-		Src:               src,
-		RowIDs:            rowIDs,
-		FilterField:       field,
-		FilterValues:      filters,
-		MinThreshold:      minThreshold,
-		TanimotoThreshold: tanimotoThreshold,
-	})
-}
 
-// executeDifferenceSlice executes a difference() call for a local slice.
-func (e *Executor) executeDifferenceSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
 	var other *Bitmap
-	if len(c.Children) == 0 {
-		return nil, fmt.Errorf("empty Difference query is currently not supported")
-	}
-	for i, input := range c.Children {
-		bm, err := e.executeBitmapCallSlice(ctx, index, input, slice)
+	for i, key := range keys {
+		switch c.Args[key].(type) {
+		case string, int64, bool, float64:
+		default:
+			return nil, fmt.Errorf("%s(): invalid value for %q", c.Name, key)
+		}
+
+		ids, err := store.Lookup(key, c.Args[key])
 		if err != nil {
 			return nil, err
 		}
 
-		if i == 0 {
+		if bm := NewBitmap(ids...); i == 0 {
 			other = bm
 		} else {
-			other = other.Difference(bm)
+			other = other.Intersect(bm)
 		}
 	}
-	other.InvalidateCount()
-	return other, nil
+	return other.Bits(), nil
 }
 
 func (e *Executor) executeBitmapSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
@@ -571,22 +1592,21 @@ func (e *Executor) executeBitmapSlice(ctx context.Context, index string, c *pql.
 
 // executeIntersectSlice executes a intersect() call for a local slice.
 func (e *Executor) executeIntersectSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
-	var other *Bitmap
 	if len(c.Children) == 0 {
 		return nil, fmt.Errorf("empty Intersect query is currently not supported")
 	}
+
+	bitmaps := make([]*Bitmap, len(c.Children))
 	for i, input := range c.Children {
 		bm, err := e.executeBitmapCallSlice(ctx, index, input, slice)
 		if err != nil {
 			return nil, err
 		}
-
-		if i == 0 {
-			other = bm
-		} else {
-			other = other.Intersect(bm)
-		}
+		bitmaps[i] = bm
 	}
+
+	// Intersect all children at once rather than pairwise.
+	other := IntersectBitmaps(bitmaps...)
 	other.InvalidateCount()
 	return other, nil
 }
@@ -668,16 +1688,16 @@ func (e *Executor) executeRangeSlice(ctx context.Context, index string, c *pql.C
 	}
 
 	// Union bitmaps across all time-based subframes.
-	bm := &Bitmap{}
+	var rows []*Bitmap
 	for _, view := range ViewsByTimeRange(viewName, startTime, endTime, q) {
 		f := e.Holder.Fragment(index, frame, view, slice)
 		if f == nil {
 			continue
 		}
-		bm = bm.Union(f.Row(id))
+		rows = append(rows, f.Row(id))
 	}
 	f.Stats.Count("range", 1, 1.0)
-	return bm, nil
+	return UnionBitmaps(rows...), nil
 }
 
 // executeFieldRangeSlice executes a range(field) call for a local slice.
@@ -714,6 +1734,14 @@ func (e *Executor) executeFieldRangeSlice(ctx context.Context, index string, c *
 		fieldName, cond = k, vv
 	}
 
+	// Find field & fragment. The fragment must be read with the same field
+	// definition so its encoding isn't replaced by a concurrent re-range.
+	field, frag, release := f.fieldFragment(fieldName, slice)
+	defer release()
+	if field == nil {
+		return nil, ErrFieldNotFound
+	}
+
 	// EQ null           (not implemented: flip frag.FieldNotNull with max ColumnID)
 	// NEQ null          frag.FieldNotNull()
 	// BETWEEN a,b(in)   BETWEEN/frag.FieldRangeBetween()
@@ -723,14 +1751,6 @@ func (e *Executor) executeFieldRangeSlice(ctx context.Context, index string, c *
 
 	// Handle `!= null`.
 	if cond.Op == pql.NEQ && cond.Value == nil {
-		// Find field.
-		field := f.Field(fieldName)
-		if field == nil {
-			return nil, ErrFieldNotFound
-		}
-
-		// Retrieve fragment.
-		frag := e.Holder.Fragment(index, frame, ViewFieldPrefix+fieldName, slice)
 		if frag == nil {
 			return NewBitmap(), nil
 		}
@@ -738,34 +1758,25 @@ func (e *Executor) executeFieldRangeSlice(ctx context.Context, index string, c *
 		return frag.FieldNotNull(field.BitDepth())
 
 	} else if cond.Op == pql.BETWEEN {
-
-		predicates, err := cond.IntSliceValue()
+		predicates, err := fieldBetweenValues(field, cond)
 		if err != nil {
 			return nil, err
 		}
 
-		// Only support two integers for the between operation.
+		// Only support two values for the between operation.
 		if len(predicates) != 2 {
-			return nil, errors.New("Range(): BETWEEN condition requires exactly two integer values")
+			return nil, errors.New("Range(): BETWEEN condition requires exactly two values")
 		}
 
 		// The reason we don't just call:
 		//     return f.FieldRangeBetween(fieldName, predicates[0], predicates[1])
 		// here is because we need the call to be slice-specific.
 
-		// Find field.
-		field := f.Field(fieldName)
-		if field == nil {
-			return nil, ErrFieldNotFound
-		}
-
 		baseValueMin, baseValueMax, outOfRange := field.BaseValueBetween(predicates[0], predicates[1])
 		if outOfRange {
 			return NewBitmap(), nil
 		}
 
-		// Retrieve fragment.
-		frag := e.Holder.Fragment(index, frame, ViewFieldPrefix+fieldName, slice)
 		if frag == nil {
 			return NewBitmap(), nil
 		}
@@ -779,17 +1790,12 @@ func (e *Executor) executeFieldRangeSlice(ctx context.Context, index string, c *
 		return frag.FieldRangeBetween(field.BitDepth(), baseValueMin, baseValueMax)
 
 	} else {
-
-		// Only support integers for now.
-		value, ok := cond.Value.(int64)
-		if !ok {
-			return nil, errors.New("Range(): conditions only support integer values")
-		}
-
-		// Find field.
-		field := f.Field(fieldName)
-		if field == nil {
-			return nil, ErrFieldNotFound
+		// Convert value to the field's scaled units.
+		value, err := field.ParseValue(cond.Value)
+		if err == ErrInvalidFieldValueType {
+			return nil, errors.New("Range(): conditions only support numeric values")
+		} else if err != nil {
+			return nil, err
 		}
 
 		baseValue, outOfRange := field.BaseValue(cond.Op, value)
@@ -797,8 +1803,6 @@ func (e *Executor) executeFieldRangeSlice(ctx context.Context, index string, c *
 			return NewBitmap(), nil
 		}
 
-		// Retrieve fragment.
-		frag := e.Holder.Fragment(index, frame, ViewFieldPrefix+fieldName, slice)
 		if frag == nil {
 			return NewBitmap(), nil
 		}
@@ -813,21 +1817,41 @@ func (e *Executor) executeFieldRangeSlice(ctx context.Context, index string, c *
 	}
 }
 
+// fieldBetweenValues converts the values of a BETWEEN condition into the
+// field's scaled units.
+func fieldBetweenValues(field *Field, cond *pql.Condition) ([]int64, error) {
+	if field.Type == FieldTypeInt {
+		return cond.IntSliceValue()
+	}
+
+	values, ok := cond.Value.([]interface{})
+	if !ok {
+		return nil, ErrInvalidBetweenValue
+	}
+	a := make([]int64, len(values))
+	for i, v := range values {
+		value, err := field.ParseValue(v)
+		if err != nil {
+			return nil, err
+		}
+		a[i] = value
+	}
+	return a, nil
+}
+
 // executeUnionSlice executes a union() call for a local slice.
 func (e *Executor) executeUnionSlice(ctx context.Context, index string, c *pql.Call, slice uint64) (*Bitmap, error) {
-	other := NewBitmap()
+	bitmaps := make([]*Bitmap, len(c.Children))
 	for i, input := range c.Children {
 		bm, err := e.executeBitmapCallSlice(ctx, index, input, slice)
 		if err != nil {
 			return nil, err
 		}
-
-		if i == 0 {
-			other = bm
-		} else {
-			other = other.Union(bm)
-		}
+		bitmaps[i] = bm
 	}
+
+	// Union all children at once rather than pairwise.
+	other := UnionBitmaps(bitmaps...)
 	other.InvalidateCount()
 	return other, nil
 }
@@ -841,10 +1865,15 @@ func (e *Executor) executeXorSlice(ctx context.Context, index string, c *pql.Cal
 			return nil, err
 		}
 
-		if i == 0 {
+		// The first input may be shared so it's copied by the first xor.
+		// The copy is then modified in place.
+		switch i {
+		case 0:
 			other = bm
-		} else {
+		case 1:
 			other = other.Xor(bm)
+		default:
+			other.XorInPlace(bm)
 		}
 	}
 	other.InvalidateCount()
@@ -941,37 +1970,137 @@ func (e *Executor) executeClearBit(ctx context.Context, index string, c *pql.Cal
 				ret = true
 			}
 		}
-		return ret, nil
-	default:
-		return false, fmt.Errorf("invalid view: %s", view)
-	}
-}
+		return ret, nil
+	default:
+		return false, fmt.Errorf("invalid view: %s", view)
+	}
+}
+
+// executeClearBitView executes a ClearBit() call for a single view.
+func (e *Executor) executeClearBitView(ctx context.Context, index string, c *pql.Call, f *Frame, view string, colID, rowID uint64, opt *ExecOptions) (bool, error) {
+	slice := colID / SliceWidth
+	ret := false
+	for _, node := range e.Cluster.FragmentNodes(index, slice) {
+		// Update locally if host matches.
+		if node.Host == e.Host {
+			val, err := f.ClearBit(view, rowID, colID, nil)
+			if err != nil {
+				return false, err
+			} else if val {
+				ret = true
+			}
+			continue
+		}
+		// Do not forward call if this is already being forwarded.
+		if opt.Remote {
+			continue
+		}
+
+		// Forward call to remote node otherwise.
+		if res, err := e.exec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt); err != nil {
+			return false, err
+		} else {
+			ret = res[0].(bool)
+		}
+	}
+	return ret, nil
+}
+
+// executeClearRow executes a ClearRow() call which clears a row from every
+// standard & time view in each slice. The row's column is also cleared from
+// the inverse views, if enabled.
+func (e *Executor) executeClearRow(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) (bool, error) {
+	frame, ok := c.Args["frame"].(string)
+	if !ok {
+		return false, errors.New("ClearRow() frame required")
+	}
+	f := e.Holder.Frame(index, frame)
+	if f == nil {
+		return false, ErrFrameNotFound
+	}
+
+	rowLabel := f.RowLabel()
+	rowID, ok, err := c.UintArg(rowLabel)
+	if err != nil {
+		return false, fmt.Errorf("reading ClearRow() row: %v", err)
+	} else if !ok {
+		return false, fmt.Errorf("ClearRow() row field '%v' required", rowLabel)
+	}
+
+	// Only clear locally if this call has been forwarded.
+	var nodes []*Node
+	if !opt.Remote {
+		nodes = e.Cluster.Nodes
+	} else {
+		nodes = []*Node{e.Cluster.NodeByHost(e.Host)}
+	}
+
+	// The inverse views store the row as a column in a single slice.
+	inverseSlice := rowID / SliceWidth
+
+	var ret bool
+	for _, node := range nodes {
+		var nodeSlices []uint64
+		for _, slice := range slices {
+			if e.Cluster.OwnsFragment(node.Host, index, slice) {
+				nodeSlices = append(nodeSlices, slice)
+			}
+		}
+		ownsInverse := f.InverseEnabled() && e.Cluster.OwnsFragment(node.Host, index, inverseSlice)
+		if len(nodeSlices) == 0 && !ownsInverse {
+			continue
+		}
 
-// executeClearBitView executes a ClearBit() call for a single view.
-func (e *Executor) executeClearBitView(ctx context.Context, index string, c *pql.Call, f *Frame, view string, colID, rowID uint64, opt *ExecOptions) (bool, error) {
-	slice := colID / SliceWidth
-	ret := false
-	for _, node := range e.Cluster.FragmentNodes(index, slice) {
-		// Update locally if host matches.
+		// Clear locally if host matches.
 		if node.Host == e.Host {
-			val, err := f.ClearBit(view, rowID, colID, nil)
+			changed, err := e.executeClearRowLocal(f, rowID, nodeSlices, ownsInverse)
 			if err != nil {
 				return false, err
-			} else if val {
+			} else if changed {
 				ret = true
 			}
 			continue
 		}
-		// Do not forward call if this is already being forwarded.
-		if opt.Remote {
-			continue
-		}
 
 		// Forward call to remote node otherwise.
-		if res, err := e.exec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt); err != nil {
+		res, err := e.exec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nodeSlices, opt)
+		if err != nil {
 			return false, err
-		} else {
-			ret = res[0].(bool)
+		} else if res[0].(bool) {
+			ret = true
+		}
+	}
+	return ret, nil
+}
+
+// executeClearRowLocal clears a row from the local fragments of a frame.
+func (e *Executor) executeClearRowLocal(f *Frame, rowID uint64, slices []uint64, inverse bool) (bool, error) {
+	var ret bool
+	for _, view := range f.Views() {
+		switch {
+		case view.Name() == ViewStandard || strings.HasPrefix(view.Name(), ViewStandard+"_"):
+			for _, slice := range slices {
+				frag := view.Fragment(slice)
+				if frag == nil {
+					continue
+				}
+				if changed, err := frag.ClearRow(rowID); err != nil {
+					return false, err
+				} else if changed {
+					ret = true
+				}
+			}
+
+		case inverse && IsInverseView(view.Name()):
+			frag := view.Fragment(rowID / SliceWidth)
+			if frag == nil {
+				continue
+			}
+			if changed, err := frag.ClearColumn(rowID); err != nil {
+				return false, err
+			} else if changed {
+				ret = true
+			}
 		}
 	}
 	return ret, nil
@@ -1083,6 +2212,135 @@ func (e *Executor) executeSetBitView(ctx context.Context, index string, c *pql.C
 	return ret, nil
 }
 
+// executeStore executes a Store() call which writes the result of its
+// bitmap input into a row. Each owner of a slice computes and stores the
+// result from its own copy of the slice.
+func (e *Executor) executeStore(ctx context.Context, index string, c *pql.Call, slices []uint64, opt *ExecOptions) error {
+	if len(c.Children) != 1 {
+		return errors.New("Store() requires a single bitmap input")
+	}
+
+	frame, _ := c.Args["frame"].(string)
+	if frame == "" {
+		return errors.New("Store(): frame required")
+	}
+	f := e.Holder.Frame(index, frame)
+	if f == nil {
+		return ErrFrameNotFound
+	}
+
+	rowLabel := f.RowLabel()
+	rowID, ok, err := c.UintArg(rowLabel)
+	if err != nil {
+		return fmt.Errorf("reading Store() row: %v", err)
+	} else if !ok {
+		return fmt.Errorf("Store() row field '%v' required", rowLabel)
+	}
+
+	// Only store locally if this call has been forwarded.
+	var nodes []*Node
+	if !opt.Remote {
+		nodes = e.Cluster.Nodes
+	} else {
+		nodes = []*Node{e.Cluster.NodeByHost(e.Host)}
+	}
+
+	for _, node := range nodes {
+		var nodeSlices []uint64
+		for _, slice := range slices {
+			if e.Cluster.OwnsFragment(node.Host, index, slice) {
+				nodeSlices = append(nodeSlices, slice)
+			}
+		}
+		if len(nodeSlices) == 0 {
+			continue
+		}
+
+		// Store locally if host matches.
+		if node.Host == e.Host {
+			mapFn := func(slice uint64) (interface{}, error) {
+				return nil, e.executeStoreSlice(ctx, index, c, f, rowID, slice)
+			}
+			reduceFn := func(prev, v interface{}) interface{} { return nil }
+			if _, err := e.mapperLocal(ctx, nodeSlices, mapFn, reduceFn); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Forward call to remote node otherwise.
+		if _, err := e.exec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nodeSlices, opt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeStoreSlice writes the result of a Store() call's input for a
+// local slice into the row. Bits are written through Frame.Import() so the
+// inverse view, column existence, and row cache are updated the same way
+// as a bulk import.
+func (e *Executor) executeStoreSlice(ctx context.Context, index string, c *pql.Call, f *Frame, rowID, slice uint64) error {
+	bm, err := e.executeBitmapCallSlice(ctx, index, c.Children[0], slice)
+	if err != nil {
+		return err
+	}
+
+	// Clear the existing row so it only holds the result afterward.
+	if err := e.clearStoreRowSlice(f, rowID, slice); err != nil {
+		return err
+	}
+
+	columnIDs := bm.Bits()
+	if len(columnIDs) == 0 {
+		return nil
+	}
+
+	rowIDs := make([]uint64, len(columnIDs))
+	for i := range rowIDs {
+		rowIDs[i] = rowID
+	}
+	return f.Import(rowIDs, columnIDs, make([]*time.Time, len(columnIDs)))
+}
+
+// clearStoreRowSlice clears a row within a single slice of the views that
+// Store() writes: the standard view and, if enabled, the inverse view. Time
+// views are left unchanged so the row's history is kept.
+func (e *Executor) clearStoreRowSlice(f *Frame, rowID, slice uint64) error {
+	view := f.View(ViewStandard)
+	if view == nil {
+		return nil
+	}
+	frag := view.Fragment(slice)
+	if frag == nil {
+		return nil
+	}
+	columnIDs := frag.Row(rowID).Bits()
+	if len(columnIDs) == 0 {
+		return nil
+	} else if _, err := frag.ClearRow(rowID); err != nil {
+		return err
+	}
+
+	if !f.InverseEnabled() {
+		return nil
+	}
+	view = f.View(ViewInverse)
+	if view == nil {
+		return nil
+	}
+	frag = view.Fragment(rowID / SliceWidth)
+	if frag == nil {
+		return nil
+	}
+	for _, columnID := range columnIDs {
+		if _, err := frag.ClearBit(columnID, rowID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // executeSetFieldValue executes a SetFieldValue() call.
 func (e *Executor) executeSetFieldValue(ctx context.Context, index string, c *pql.Call, opt *ExecOptions) error {
 	frameName, ok := c.Args["frame"].(string)
@@ -1120,13 +2378,18 @@ func (e *Executor) executeSetFieldValue(ctx context.Context, index string, c *pq
 
 	// Set values.
 	for name, value := range args {
-		switch value := value.(type) {
-		case int64:
-			if _, err := frame.SetFieldValue(columnID, name, value); err != nil {
-				return err
-			}
-		default:
-			return ErrInvalidFieldValueType
+		field := frame.Field(name)
+		if field == nil {
+			return ErrFieldNotFound
+		}
+
+		// Convert value to the field's scaled units.
+		v, err := field.ParseValue(value)
+		if err != nil {
+			return err
+		}
+		if _, err := frame.SetFieldValue(columnID, name, v); err != nil {
+			return err
 		}
 	}
 	frame.Stats.Count("SetFieldValue", 1, 1.0)
@@ -1362,11 +2625,20 @@ func (e *Executor) executeSetColumnAttrs(ctx context.Context, index string, c *p
 
 // exec executes a PQL query remotely for a set of slices on a node.
 func (e *Executor) exec(ctx context.Context, node *Node, index string, q *pql.Query, slices []uint64, opt *ExecOptions) (results []interface{}, err error) {
+	prof := ProfileFromContext(ctx).Start(&Profile{Name: "remote", Host: node.Host, Slices: slices})
+
+	// Send bindings so the remote node can also reuse bound calls.
+	if cache := bindingCacheFromContext(ctx); cache != nil {
+		q = &pql.Query{Bindings: cache.bindings, Calls: q.Calls}
+	}
+
 	// Encode request object.
 	pbreq := &internal.QueryRequest{
-		Query:  q.String(),
-		Slices: slices,
-		Remote: true,
+		Query:   q.String(),
+		Slices:  slices,
+		Remote:  true,
+		Profile: prof != nil,
+		QueryID: opt.QueryID,
 	}
 	uri, err := NewURIFromAddress(node.Host)
 	if err != nil {
@@ -1393,16 +2665,21 @@ func (e *Executor) exec(ctx context.Context, node *Node, index string, q *pql.Qu
 		switch call.Name {
 		case "Average", "Sum":
 			v, err = decodeSumCount(pb.Results[i].GetSumCount()), nil
+		case "Min", "Max", "Percentile":
+			v, err = decodeValCount(pb.Results[i].GetValCount()), nil
 		case "TopN":
 			v, err = decodePairs(pb.Results[i].GetPairs()), nil
+		case "GroupBy":
+			v, err = decodeGroupCounts(pb.Results[i].GetGroupCounts()), nil
 		case "Count":
 			v, err = pb.Results[i].N, nil
 		case "SetBit":
 			v, err = pb.Results[i].Changed, nil
-		case "ClearBit":
+		case "ClearBit", "ClearRow":
 			v, err = pb.Results[i].Changed, nil
 		case "SetRowAttrs":
 		case "SetColumnAttrs":
+		case "Store":
 		default:
 			v, err = decodeBitmap(pb.Results[i].GetBitmap()), nil
 		}
@@ -1412,6 +2689,17 @@ func (e *Executor) exec(ctx context.Context, node *Node, index string, q *pql.Qu
 
 		results[i] = v
 	}
+
+	// Attach the remote node's profile.
+	if prof != nil {
+		prof.Add(decodeProfile(pb.Profile))
+		if len(results) == 1 {
+			prof.Finish(results[0])
+		} else {
+			prof.Finish(nil)
+		}
+	}
+
 	return results, nil
 }
 
@@ -1533,16 +2821,41 @@ func (e *Executor) mapper(ctx context.Context, ch chan mapResponse, nodes []*Nod
 func (e *Executor) mapperLocal(ctx context.Context, slices []uint64, mapFn mapFunc, reduceFn reduceFunc) (interface{}, error) {
 	ch := make(chan mapResponse, len(slices))
 
+	// Queue slices for a bounded number of workers so that the remaining
+	// slices are skipped once the query is canceled or times out.
+	queue := make(chan uint64, len(slices))
 	for _, slice := range slices {
-		go func(slice uint64) {
-			result, err := mapFn(slice)
+		queue <- slice
+	}
+	close(queue)
 
-			// Return response to the channel.
-			select {
-			case <-ctx.Done():
-			case ch <- mapResponse{result: result, err: err}:
+	workerN := runtime.NumCPU()
+	if workerN > len(slices) {
+		workerN = len(slices)
+	}
+	for i := 0; i < workerN; i++ {
+		go func() {
+			for slice := range queue {
+				if err := ctx.Err(); err != nil {
+					select {
+					case <-ctx.Done():
+					case ch <- mapResponse{err: err}:
+					}
+					return
+				}
+
+				prof := ProfileFromContext(ctx).Start(&Profile{Name: "slice", Slices: []uint64{slice}})
+				result, err := mapFn(slice)
+				prof.Finish(result)
+
+				// Return response to the channel.
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- mapResponse{result: result, err: err}:
+				}
 			}
-		}(slice)
+		}()
 	}
 
 	// Reduce results
@@ -1587,13 +2900,191 @@ type ExecOptions struct {
 	Remote       bool
 	ExcludeAttrs bool
 	ExcludeBits  bool
+
+	// Attribute keys to include in Bitmap() results. If empty, all
+	// attributes are included.
+	AttrKeys []string
+
+	// Pagination of bits in top-level bitmap results. Limit is the maximum
+	// number of bits returned, if non-zero. Offset is the number of bits
+	// skipped after the cursor. Cursor is returned by a previous page.
+	Limit  uint64
+	Offset uint64
+	Cursor string
+
+	// QueryID identifies the query that a remote request is part of.
+	// It is only set for queries with bindings.
+	QueryID string
+}
+
+// bindingResultsTTL is how long the bound results of a query are kept after
+// they were last retained. Remote nodes can't tell when a query is finished
+// so they rely on this to release them.
+const bindingResultsTTL = time.Minute
+
+// newQueryID returns a random ID for a query.
+func newQueryID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// retainBindingResults returns the bound results for a query ID. Results for
+// other queries which haven't been retained within bindingResultsTTL are
+// released.
+func (e *Executor) retainBindingResults(id string) *bindingResults {
+	e.bindingMu.Lock()
+	defer e.bindingMu.Unlock()
+
+	now := time.Now()
+	for other, r := range e.bindingResults {
+		if now.Sub(r.retained) > bindingResultsTTL {
+			delete(e.bindingResults, other)
+		}
+	}
+
+	if e.bindingResults == nil {
+		e.bindingResults = make(map[string]*bindingResults)
+	}
+	r := e.bindingResults[id]
+	if r == nil {
+		r = &bindingResults{entries: make(map[bindingCacheKey]*bindingCacheEntry)}
+		e.bindingResults[id] = r
+	}
+	r.retained = now
+	return r
+}
+
+// releaseBindingResults releases the bound results for a query ID.
+func (e *Executor) releaseBindingResults(id string) {
+	e.bindingMu.Lock()
+	defer e.bindingMu.Unlock()
+	delete(e.bindingResults, id)
+}
+
+// bindingCache stores the per-slice results of bound calls for a query.
+type bindingCache struct {
+	bindings []*pql.Binding
+	names    map[*pql.Call]string
+	results  *bindingResults
+}
+
+// bindingResults stores the per-slice results of bound calls by binding name.
+type bindingResults struct {
+	retained time.Time // guarded by Executor.bindingMu
+
+	mu      sync.Mutex
+	entries map[bindingCacheKey]*bindingCacheEntry
+}
+
+type bindingCacheKey struct {
+	name  string
+	slice uint64
+}
+
+type bindingCacheEntry struct {
+	once sync.Once
+	bm   *Bitmap
+	err  error
+}
+
+// newBindingCache returns a new cache for a query's bindings which stores
+// results in r.
+func newBindingCache(bindings []*pql.Binding, r *bindingResults) *bindingCache {
+	c := &bindingCache{
+		bindings: bindings,
+		names:    make(map[*pql.Call]string, len(bindings)),
+		results:  r,
+	}
+	for _, b := range bindings {
+		c.names[b.Call] = b.Name
+	}
+	return c
+}
+
+// bound returns true if call is bound to a name.
+func (c *bindingCache) bound(call *pql.Call) bool {
+	_, ok := c.names[call]
+	return ok
+}
+
+// fetch returns the result of call for a slice. The result is computed by fn
+// the first time the slice is requested.
+func (c *bindingCache) fetch(call *pql.Call, slice uint64, fn func() (*Bitmap, error)) (*Bitmap, error) {
+	key := bindingCacheKey{name: c.names[call], slice: slice}
+
+	r := c.results
+	r.mu.Lock()
+	entry := r.entries[key]
+	if entry == nil {
+		entry = &bindingCacheEntry{}
+		r.entries[key] = entry
+	}
+	r.mu.Unlock()
+
+	entry.once.Do(func() { entry.bm, entry.err = fn() })
+	return entry.bm, entry.err
+}
+
+type bindingCacheContextKey struct{}
+
+// withBindingCache returns a copy of ctx which stores bound results in c.
+func withBindingCache(ctx context.Context, c *bindingCache) context.Context {
+	return context.WithValue(ctx, bindingCacheContextKey{}, c)
+}
+
+// bindingCacheFromContext returns the binding cache for the query in ctx.
+// Returns nil if the query has no bindings.
+func bindingCacheFromContext(ctx context.Context) *bindingCache {
+	c, _ := ctx.Value(bindingCacheContextKey{}).(*bindingCache)
+	return c
+}
+
+// attrLookupCache stores the ids matched by the ColumnAttrs() & RowAttrs()
+// calls of a request so the attribute store is only searched once per call
+// rather than once per slice.
+type attrLookupCache struct {
+	mu      sync.Mutex
+	entries map[*pql.Call]*attrLookupCacheEntry
+}
+
+type attrLookupCacheEntry struct {
+	once   sync.Once
+	slices map[uint64][]uint64
+	err    error
+}
+
+type attrLookupCacheContextKey struct{}
+
+// withAttrLookupCache returns a copy of ctx with a new attribute lookup cache.
+func withAttrLookupCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, attrLookupCacheContextKey{}, &attrLookupCache{
+		entries: make(map[*pql.Call]*attrLookupCacheEntry),
+	})
+}
+
+// attrLookupCacheFromContext returns the attribute lookup cache in ctx.
+func attrLookupCacheFromContext(ctx context.Context) *attrLookupCache {
+	c, _ := ctx.Value(attrLookupCacheContextKey{}).(*attrLookupCache)
+	return c
+}
+
+// paginated returns true if bitmap results should be paginated.
+func (opt *ExecOptions) paginated() bool {
+	return opt.Limit > 0 || opt.Offset > 0 || opt.Cursor != ""
 }
 
 // decodeError returns an error representation of s if s is non-blank.
-// Returns nil if s is blank.
+// Returns nil if s is blank. Remote timeouts are mapped back to
+// context.DeadlineExceeded so they can be reported as such.
 func decodeError(s string) error {
-	if s == "" {
+	switch s {
+	case "":
 		return nil
+	case context.DeadlineExceeded.Error():
+		return context.DeadlineExceeded
 	}
 	return errors.New(s)
 }
@@ -1631,22 +3122,41 @@ func needsSlices(calls []*pql.Call) bool {
 }
 
 // SumCount represents a grouping of sum & count for Sum() and Average() calls.
+// Sum is scaled by 10^Scale for decimal fields.
 type SumCount struct {
-	Sum   int64 `json:"sum"`
-	Count int64 `json:"count"`
+	Sum   int64  `json:"sum"`
+	Count int64  `json:"count"`
+	Scale uint32 `json:"-"`
 }
 
 func (sc *SumCount) Add(other SumCount) SumCount {
+	scale := sc.Scale
+	if sc.Count == 0 {
+		scale = other.Scale
+	}
 	return SumCount{
 		Sum:   sc.Sum + other.Sum,
 		Count: sc.Count + other.Count,
+		Scale: scale,
 	}
 }
 
+// MarshalJSON encodes sc with the sum formatted as a decimal.
+func (sc SumCount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Sum   json.Number `json:"sum"`
+		Count int64       `json:"count"`
+	}{
+		Sum:   json.Number(FormatDecimal(sc.Sum, sc.Scale)),
+		Count: sc.Count,
+	})
+}
+
 func encodeSumCount(sc SumCount) *internal.SumCount {
 	return &internal.SumCount{
 		Sum:   sc.Sum,
 		Count: sc.Count,
+		Scale: sc.Scale,
 	}
 }
 
@@ -1654,5 +3164,140 @@ func decodeSumCount(pb *internal.SumCount) SumCount {
 	return SumCount{
 		Sum:   pb.Sum,
 		Count: pb.Count,
+		Scale: pb.Scale,
+	}
+}
+
+// ValCount represents a grouping of a value & count for Min() and Max() calls.
+// Val is scaled by 10^Scale for decimal fields.
+type ValCount struct {
+	Val   int64  `json:"value"`
+	Count int64  `json:"count"`
+	Scale uint32 `json:"-"`
+}
+
+// MarshalJSON encodes vc with the value formatted as a decimal.
+func (vc ValCount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Val   json.Number `json:"value"`
+		Count int64       `json:"count"`
+	}{
+		Val:   json.Number(FormatDecimal(vc.Val, vc.Scale)),
+		Count: vc.Count,
+	})
+}
+
+// Smaller returns the smaller of vc and other.
+// The counts are combined when both hold the same value.
+func (vc *ValCount) Smaller(other ValCount) ValCount {
+	if vc.Count == 0 || (other.Count > 0 && other.Val < vc.Val) {
+		return other
+	} else if other.Count > 0 && other.Val == vc.Val {
+		return ValCount{Val: vc.Val, Count: vc.Count + other.Count, Scale: vc.Scale}
+	}
+	return *vc
+}
+
+// Larger returns the larger of vc and other.
+// The counts are combined when both hold the same value.
+func (vc *ValCount) Larger(other ValCount) ValCount {
+	if vc.Count == 0 || (other.Count > 0 && other.Val > vc.Val) {
+		return other
+	} else if other.Count > 0 && other.Val == vc.Val {
+		return ValCount{Val: vc.Val, Count: vc.Count + other.Count, Scale: vc.Scale}
+	}
+	return *vc
+}
+
+func encodeValCount(vc ValCount) *internal.ValCount {
+	return &internal.ValCount{
+		Val:   vc.Val,
+		Count: vc.Count,
+		Scale: vc.Scale,
+	}
+}
+
+func decodeValCount(pb *internal.ValCount) ValCount {
+	return ValCount{
+		Val:   pb.Val,
+		Count: pb.Count,
+		Scale: pb.Scale,
+	}
+}
+
+// GroupCount represents the intersection count for a group of row IDs.
+type GroupCount struct {
+	Group []uint64 `json:"group"`
+	Count uint64   `json:"count"`
+}
+
+// GroupCounts is a list of GroupCount objects sorted by group.
+type GroupCounts []GroupCount
+
+func (p GroupCounts) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p GroupCounts) Len() int      { return len(p) }
+func (p GroupCounts) Less(i, j int) bool {
+	a, b := p[i].Group, p[j].Group
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if a[k] != b[k] {
+			return a[k] < b[k]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// Add merges other into p and returns a new slice.
+func (p GroupCounts) Add(other []GroupCount) []GroupCount {
+	// Create lookup of group/counts.
+	m := make(map[string]GroupCount, len(p))
+	for _, gc := range p {
+		m[groupKey(gc.Group)] = gc
+	}
+
+	// Add/merge from other.
+	for _, gc := range other {
+		key := groupKey(gc.Group)
+		if prev, ok := m[key]; ok {
+			gc.Count += prev.Count
+		}
+		m[key] = gc
+	}
+
+	// Convert back to slice.
+	a := make([]GroupCount, 0, len(m))
+	for _, gc := range m {
+		a = append(a, gc)
+	}
+	return a
+}
+
+// groupKey returns a string usable as a map key for a group of row IDs.
+func groupKey(group []uint64) string {
+	buf := make([]byte, 8*len(group))
+	for i, id := range group {
+		binary.BigEndian.PutUint64(buf[i*8:], id)
+	}
+	return string(buf)
+}
+
+func encodeGroupCounts(a []GroupCount) []*internal.GroupCount {
+	other := make([]*internal.GroupCount, len(a))
+	for i := range a {
+		other[i] = &internal.GroupCount{
+			Group: a[i].Group,
+			Count: a[i].Count,
+		}
+	}
+	return other
+}
+
+func decodeGroupCounts(a []*internal.GroupCount) []GroupCount {
+	other := make([]GroupCount, len(a))
+	for i := range a {
+		other[i] = GroupCount{
+			Group: a[i].Group,
+			Count: a[i].Count,
+		}
 	}
+	return other
 }
@@ -16,10 +16,17 @@ package pilosa_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"reflect"
+	"sort"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pilosa/pilosa"
@@ -77,6 +84,13 @@ func TestExecutor_Execute_Bitmap(t *testing.T) {
 		} else if attrs := res[0].(*pilosa.Bitmap).Attrs; !reflect.DeepEqual(attrs, map[string]interface{}{}) {
 			t.Fatalf("unexpected attrs: %s", spew.Sdump(attrs))
 		}
+
+		// Restrict attributes.
+		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(rowID=10, frame=f)`), nil, &pilosa.ExecOptions{AttrKeys: []string{"baz", "qux"}}); err != nil {
+			t.Fatal(err)
+		} else if attrs := res[0].(*pilosa.Bitmap).Attrs; !reflect.DeepEqual(attrs, map[string]interface{}{"baz": int64(123)}) {
+			t.Fatalf("unexpected attrs: %s", spew.Sdump(attrs))
+		}
 	})
 
 	t.Run("Column", func(t *testing.T) {
@@ -111,6 +125,175 @@ func TestExecutor_Execute_Bitmap(t *testing.T) {
 	})
 }
 
+// Ensure bitmap results can be paginated by limit, offset & cursor.
+func TestExecutor_Execute_Bitmap_Pagination(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	for _, columnID := range []uint64{1, 2, 3, SliceWidth + 1, SliceWidth + 5, 3*SliceWidth + 3} {
+		hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, columnID/SliceWidth).SetBit(10, columnID)
+	}
+
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+	page := func(opt *pilosa.ExecOptions) *pilosa.Bitmap {
+		res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(rowID=10, frame=f)`), nil, opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res[0].(*pilosa.Bitmap)
+	}
+
+	// Walk through every page using cursors.
+	var pages [][]uint64
+	var cursor string
+	for {
+		bm := page(&pilosa.ExecOptions{Limit: 2, Cursor: cursor})
+		pages = append(pages, bm.Bits())
+		if cursor = bm.Cursor; cursor == "" {
+			break
+		}
+	}
+	if !reflect.DeepEqual(pages, [][]uint64{
+		{1, 2},
+		{3, SliceWidth + 1},
+		{SliceWidth + 5, 3*SliceWidth + 3},
+		{},
+	}) {
+		t.Fatalf("unexpected pages: %v", pages)
+	}
+
+	// Skip bits by offset, including entire slices.
+	if bits := page(&pilosa.ExecOptions{Limit: 2, Offset: 3}).Bits(); !reflect.DeepEqual(bits, []uint64{SliceWidth + 1, SliceWidth + 5}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	} else if bm := page(&pilosa.ExecOptions{Offset: 4}); !reflect.DeepEqual(bm.Bits(), []uint64{SliceWidth + 5, 3*SliceWidth + 3}) || bm.Cursor != "" {
+		t.Fatalf("unexpected bits: %v (cursor=%q)", bm.Bits(), bm.Cursor)
+	}
+
+	// Offsets are applied after the cursor.
+	bm := page(&pilosa.ExecOptions{Limit: 1})
+	if bits := page(&pilosa.ExecOptions{Limit: 1, Offset: 2, Cursor: bm.Cursor}).Bits(); !reflect.DeepEqual(bits, []uint64{SliceWidth + 1}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(rowID=10, frame=f)`), nil, &pilosa.ExecOptions{Cursor: "!"}); err != pilosa.ErrInvalidCursor {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure bound calls can be reused by later calls in a query.
+func TestExecutor_Execute_Binding(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	hldr.MustCreateRankedFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).SetBit(10, 1)
+	hldr.MustCreateRankedFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).SetBit(10, 2)
+	hldr.MustCreateRankedFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1).SetBit(10, SliceWidth+1)
+	hldr.MustCreateRankedFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).SetBit(20, 2)
+	hldr.MustCreateRankedFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1).SetBit(20, SliceWidth+1)
+	hldr.MustCreateRankedFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1).SetBit(30, SliceWidth+1)
+	hldr.Frame("i", "f").RecalculateCaches()
+
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+	res, err := e.Execute(context.Background(), "i", test.MustParse(`
+		let seg = Intersect(Bitmap(frame=f, rowID=10), Bitmap(frame=f, rowID=20))
+		Count(seg)
+		TopN(seg, frame=f, n=2)
+		SetBit(frame=f, rowID=20, columnID=1)
+		Union(seg, Bitmap(frame=f, rowID=30))
+	`), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := res[0].(uint64); n != 2 {
+		t.Fatalf("unexpected count: %d", n)
+	}
+
+	pairs := res[1].([]pilosa.Pair)
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].ID < pairs[j].ID })
+	if !reflect.DeepEqual(pairs, []pilosa.Pair{{ID: 10, Count: 2}, {ID: 20, Count: 2}}) {
+		t.Fatalf("unexpected pairs: %s", spew.Sdump(pairs))
+	}
+
+	// The binding is only evaluated once so the write isn't included.
+	if bits := res[3].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{2, SliceWidth + 1}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	}
+}
+
+// Ensure remote requests for the same query share bound results.
+func TestExecutor_Execute_Binding_QueryID(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).MustSetBits(10, 1)
+
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+	count := func(id string) uint64 {
+		res, err := e.Execute(context.Background(), "i", test.MustParse("let seg = Bitmap(frame=f, rowID=10)\nCount(seg)"), []uint64{0}, &pilosa.ExecOptions{Remote: true, QueryID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res[0].(uint64)
+	}
+
+	if n := count("a"); n != 1 {
+		t.Fatalf("unexpected count: %d", n)
+	}
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).MustSetBits(10, 2)
+
+	// The bound result is reused for the same query but not for others.
+	if n := count("a"); n != 1 {
+		t.Fatalf("unexpected count for same query: %d", n)
+	} else if n := count("b"); n != 2 {
+		t.Fatalf("unexpected count for other query: %d", n)
+	}
+}
+
+// Ensure remote requests for a query with bindings carry the same query ID.
+func TestExecutor_Execute_Remote_Binding(t *testing.T) {
+	c := test.NewCluster(2)
+
+	// Create secondary server and update second cluster node.
+	s := test.NewServer()
+	defer s.Close()
+	c.Nodes[1].Host = s.Host()
+
+	// Report each remote request back to the test goroutine.
+	type remoteCall struct {
+		query   string
+		queryID string
+	}
+	remoteCalls := make(chan remoteCall, 2)
+	s.Handler.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		remoteCalls <- remoteCall{query: query.String(), queryID: opt.QueryID}
+		return []interface{}{uint64(0)}, nil
+	}
+
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	for slice := uint64(0); slice < 4; slice++ {
+		hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, slice).MustSetBits(10, (slice*SliceWidth)+1)
+	}
+
+	e := test.NewExecutor(hldr.Holder, c)
+	if _, err := e.Execute(context.Background(), "i", test.MustParse("let seg = Bitmap(frame=f, rowID=10)\nCount(seg)\nCount(seg)"), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var queryID string
+	for i := 0; i < 2; i++ {
+		select {
+		case call := <-remoteCalls:
+			if call.query != "let seg = Bitmap(frame=\"f\", rowID=10)\nCount(seg)" {
+				t.Fatalf("unexpected query: %s", call.query)
+			} else if call.queryID == "" || (queryID != "" && call.queryID != queryID) {
+				t.Fatalf("unexpected query id: %q", call.queryID)
+			}
+			queryID = call.queryID
+		default:
+			t.Fatal("expected remote call")
+		}
+	}
+}
+
 // Ensure a difference query can be executed.
 func TestExecutor_Execute_Difference(t *testing.T) {
 	hldr := test.MustOpenHolder()
@@ -127,6 +310,19 @@ func TestExecutor_Execute_Difference(t *testing.T) {
 	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{1, 3}) {
 		t.Fatalf("unexpected bits: %+v", bits)
 	}
+
+	// Later inputs are removed in place without changing the first row.
+	hldr.MustCreateFragmentIfNotExists("i", "general", pilosa.ViewStandard, 0).MustSetBits(12, 3)
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Difference(Bitmap(rowID=10), Bitmap(rowID=11), Bitmap(rowID=12))`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{1}) {
+		t.Fatalf("unexpected bits: %+v", bits)
+	}
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(rowID=10)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{1, 2, 3}) {
+		t.Fatalf("unexpected row bits: %+v", bits)
+	}
 }
 
 // Ensure an empty difference query behaves properly.
@@ -222,6 +418,67 @@ func TestExecutor_Execute_Xor(t *testing.T) {
 	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{0, 2, SliceWidth + 1}) {
 		t.Fatalf("unexpected bits: %+v", bits)
 	}
+
+	// Later inputs are applied in place without changing the first row.
+	hldr.MustCreateFragmentIfNotExists("i", "general", pilosa.ViewStandard, 0).MustSetBits(12, 0)
+	hldr.MustCreateFragmentIfNotExists("i", "general", pilosa.ViewStandard, 1).MustSetBits(12, SliceWidth+3)
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Xor(Bitmap(rowID=10), Bitmap(rowID=11), Bitmap(rowID=12))`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{2, SliceWidth + 1, SliceWidth + 3}) {
+		t.Fatalf("unexpected bits: %+v", bits)
+	}
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(rowID=10)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{0, SliceWidth + 1, SliceWidth + 2}) {
+		t.Fatalf("unexpected row bits: %+v", bits)
+	}
+}
+
+// Ensure a not query can be executed.
+func TestExecutor_Execute_Not(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+
+	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	if _, err := index.CreateFrameIfNotExists("general", pilosa.FrameOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := index.CreateFrameIfNotExists("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields:       []*pilosa.Field{{Name: "field0", Type: pilosa.FieldTypeInt, Min: 0, Max: 100}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Set bits and field values through queries and imports.
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
+		SetBit(frame=general, rowID=10, columnID=1)
+		SetBit(frame=general, rowID=10, columnID=2)
+		SetBit(frame=general, rowID=11, columnID=3)
+		SetFieldValue(frame=f, columnID=4, field0=20)
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if err := index.Frame("general").Import([]uint64{12, 12}, []uint64{5, SliceWidth + 1}, []*time.Time{nil, nil}); err != nil {
+		t.Fatal(err)
+	} else if err := index.Frame("f").ImportValue("field0", []uint64{SliceWidth + 2}, []int64{30}); err != nil {
+		t.Fatal(err)
+	}
+
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Not(Bitmap(rowID=10))`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{3, 4, 5, SliceWidth + 1, SliceWidth + 2}) {
+		t.Fatalf("unexpected bits: %+v", bits)
+	}
+
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Not(Union(Bitmap(rowID=10), Bitmap(rowID=12)))`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{3, 4, SliceWidth + 2}) {
+		t.Fatalf("unexpected bits: %+v", bits)
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`Not()`), nil, nil); err == nil || err.Error() != "Not() requires an input bitmap" {
+		t.Fatalf("unexpected error: %v", err)
+	}
 }
 
 // Ensure a count query can be executed.
@@ -368,6 +625,44 @@ func TestExecutor_Execute_SetFieldValue(t *testing.T) {
 	})
 }
 
+// Ensure writes are synced before the query returns under the request fsync policy.
+func TestExecutor_Execute_FsyncRequest(t *testing.T) {
+	hldr := test.NewHolder()
+	defer hldr.Close()
+	hldr.FsyncPolicy = pilosa.FsyncRequest
+	if err := hldr.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	if _, err := index.CreateFrameIfNotExists("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields: []*pilosa.Field{
+			{Name: "field0", Type: pilosa.FieldTypeInt, Min: 0, Max: 50},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`SetFieldValue(columnID=10, frame=f, field0=25)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if f := hldr.Fragment("i", "f", pilosa.ViewFieldPrefix+"field0", 0); f == nil {
+		t.Fatal("expected fragment")
+	} else if f.Dirty() {
+		t.Fatal("expected fragment to be synced")
+	}
+
+	// Writes from earlier calls are synced even if a later call fails.
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(fmt.Sprintf(`SetFieldValue(columnID=%d, frame=f, field0=30) Bitmap(frame=nosuchframe, rowID=1)`, SliceWidth)), nil, nil); err != pilosa.ErrFrameNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	} else if f := hldr.Fragment("i", "f", pilosa.ViewFieldPrefix+"field0", 1); f == nil {
+		t.Fatal("expected fragment")
+	} else if f.Dirty() {
+		t.Fatal("expected fragment to be synced")
+	}
+}
+
 // Ensure a SetRowAttrs() query can be executed.
 func TestExecutor_Execute_SetRowAttrs(t *testing.T) {
 	hldr := test.MustOpenHolder()
@@ -460,6 +755,97 @@ func TestExecutor_Execute_TopN(t *testing.T) {
 	})
 }
 
+// Ensure TopN can rank rows across the time views covering a time range.
+func TestExecutor_Execute_TopN_TimeRange(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := idx.CreateFrame("f", pilosa.FrameOptions{CacheType: pilosa.CacheTypeRanked, TimeQuantum: pilosa.TimeQuantum("YMD")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
+		SetBit(frame=f, rowID=1, columnID=1, timestamp="2000-01-01T00:00")
+		SetBit(frame=f, rowID=1, columnID=2, timestamp="2000-01-02T00:00")
+		SetBit(frame=f, rowID=1, columnID=`+strconv.Itoa(SliceWidth+1)+`, timestamp="2000-01-03T00:00")
+		SetBit(frame=f, rowID=2, columnID=1, timestamp="2000-01-02T00:00")
+		SetBit(frame=f, rowID=2, columnID=3, timestamp="2000-02-01T00:00")
+		SetBit(frame=f, rowID=2, columnID=4, timestamp="2000-02-01T00:00")
+		SetBit(frame=f, rowID=2, columnID=5, timestamp="2000-02-01T00:00")
+		SetBit(frame=f, rowID=3, columnID=6, timestamp="2000-01-02T00:00")
+		SetBit(frame=f, rowID=10, columnID=10, timestamp="2003-01-01T00:00")
+		SetBit(frame=f, rowID=10, columnID=11, timestamp="2003-01-01T00:00")
+		SetBit(frame=f, rowID=11, columnID=10, timestamp="2003-01-02T00:00")
+		SetBit(frame=f, rowID=11, columnID=11, timestamp="2003-01-02T00:00")
+		SetBit(frame=f, rowID=12, columnID=10, timestamp="2003-01-03T00:00")
+		SetBit(frame=f, rowID=12, columnID=11, timestamp="2003-01-03T00:00")
+		SetBit(frame=f, rowID=14, columnID=12, timestamp="2003-01-01T00:00")
+		SetBit(frame=f, rowID=14, columnID=13, timestamp="2003-01-02T00:00")
+		SetBit(frame=f, rowID=14, columnID=14, timestamp="2003-01-03T00:00")
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	f.RecalculateCaches()
+
+	for _, tt := range []struct {
+		query string
+		pairs []pilosa.Pair
+	}{
+		{
+			query: `TopN(frame=f, n=3, start="2000-01-01T00:00", end="2000-01-04T00:00")`,
+			pairs: []pilosa.Pair{{ID: 1, Count: 3}, {ID: 2, Count: 1}, {ID: 3, Count: 1}},
+		},
+		{
+			query: `TopN(frame=f, n=1, start="2000-01-01T00:00", end="2000-03-01T00:00")`,
+			pairs: []pilosa.Pair{{ID: 2, Count: 4}},
+		},
+		{
+			query: `TopN(frame=f, start="2000-01-02T00:00", end="2000-01-03T00:00")`,
+			pairs: []pilosa.Pair{{ID: 1, Count: 1}, {ID: 2, Count: 1}, {ID: 3, Count: 1}},
+		},
+		{
+			// Row 14 never ranks first in a single day but does overall.
+			query: `TopN(frame=f, n=1, start="2003-01-01T00:00", end="2003-01-04T00:00")`,
+			pairs: []pilosa.Pair{{ID: 14, Count: 3}},
+		},
+		{
+			// Thresholds apply to the count across all days.
+			query: `TopN(frame=f, threshold=3, start="2003-01-01T00:00", end="2003-01-04T00:00")`,
+			pairs: []pilosa.Pair{{ID: 14, Count: 3}},
+		},
+		{
+			query: `TopN(Bitmap(frame=f, rowID=14), frame=f, tanimotoThreshold=50, start="2003-01-01T00:00", end="2003-01-04T00:00")`,
+			pairs: []pilosa.Pair{{ID: 14, Count: 3}},
+		},
+		{
+			query: `TopN(frame=f, n=2, start="2001-01-01T00:00", end="2002-01-01T00:00")`,
+			pairs: []pilosa.Pair{},
+		},
+	} {
+		result, err := e.Execute(context.Background(), "i", test.MustParse(tt.query), nil, nil)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.query, err)
+		}
+		pairs := result[0].([]pilosa.Pair)
+		sort.Slice(pairs, func(i, j int) bool {
+			return pairs[i].Count > pairs[j].Count || (pairs[i].Count == pairs[j].Count && pairs[i].ID < pairs[j].ID)
+		})
+		if len(pairs) != len(tt.pairs) || (len(pairs) > 0 && !reflect.DeepEqual(pairs, tt.pairs)) {
+			t.Fatalf("%s: unexpected result: %s", tt.query, spew.Sdump(pairs))
+		}
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`TopN(frame=f, start="2000-01-01T00:00")`), nil, nil); err == nil || err.Error() != "TopN() end time required" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestExecutor_Execute_TopN_fill(t *testing.T) {
 	hldr := test.MustOpenHolder()
 	defer hldr.Close()
@@ -577,6 +963,127 @@ func TestExecutor_Execute_TopN_Attr(t *testing.T) {
 
 }
 
+// Ensure TopN handles attribute filter expressions.
+func TestExecutor_Execute_TopN_Where(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	for rowID := uint64(1); rowID <= 4; rowID++ {
+		for columnID := uint64(0); columnID < rowID; columnID++ {
+			hldr.MustCreateRankedFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).SetBit(rowID, columnID)
+		}
+	}
+	hldr.MustCreateRankedFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).RecalculateCache()
+
+	store := hldr.Frame("i", "f").RowAttrStore()
+	for rowID, attrs := range map[uint64]map[string]interface{}{
+		1: {"category": "x", "tier": int64(3), "name": "alpha"},
+		2: {"category": "x", "tier": int64(1), "name": "beta"},
+		3: {"category": "y", "tier": 2.5, "name": "alpine"},
+		4: {"category": "x", "tier": int64(2)},
+	} {
+		if err := store.SetAttrs(rowID, attrs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+	for _, tt := range []struct {
+		query string
+		pairs []pilosa.Pair
+	}{
+		{
+			query: `TopN(frame="f", where=And(category == "x", tier >= 2))`,
+			pairs: []pilosa.Pair{{ID: 4, Count: 4}, {ID: 1, Count: 1}},
+		},
+		{
+			query: `TopN(frame="f", n=2, where=Or(Prefix(name="alp"), tier < 2))`,
+			pairs: []pilosa.Pair{{ID: 3, Count: 3}, {ID: 2, Count: 2}},
+		},
+		{
+			query: `TopN(frame="f", where=And(tier >< [2, 3], category != "x"))`,
+			pairs: []pilosa.Pair{{ID: 3, Count: 3}},
+		},
+		{
+			query: `TopN(frame="f", where=And(name == null))`,
+			pairs: []pilosa.Pair{{ID: 4, Count: 4}},
+		},
+	} {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(tt.query), nil, nil); err != nil {
+			t.Fatalf("%s: %s", tt.query, err)
+		} else if !reflect.DeepEqual(result[0], tt.pairs) {
+			t.Fatalf("%s: unexpected result: %s", tt.query, spew.Sdump(result))
+		}
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`TopN(frame="f", where=And(category > "x"))`), nil, nil); err == nil || err.Error() != `TopN(): numeric value required for "category"` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure ColumnAttrs() & RowAttrs() queries look up ids by attribute value.
+func TestExecutor_Execute_AttrLookup(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).SetBit(10, 1)
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).SetBit(10, 3)
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1).SetBit(10, SliceWidth+2)
+
+	columnStore := hldr.Index("i").ColumnAttrStore()
+	if err := columnStore.CreateIndex("country"); err != nil {
+		t.Fatal(err)
+	}
+	for columnID, attrs := range map[uint64]map[string]interface{}{
+		1:              {"country": "DE", "tier": int64(1)},
+		2:              {"country": "DE"},
+		3:              {"country": "US", "tier": int64(2)},
+		SliceWidth + 2: {"country": "DE", "tier": int64(2)},
+	} {
+		if err := columnStore.SetAttrs(columnID, attrs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rowStore := hldr.Frame("i", "f").RowAttrStore()
+	for rowID, attrs := range map[uint64]map[string]interface{}{
+		1:                {"owner": "x"},
+		2:                {"owner": "y"},
+		5:                {"owner": "x"},
+		3*SliceWidth + 1: {"owner": "z"},
+	} {
+		if err := rowStore.SetAttrs(rowID, attrs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+	for _, tt := range []struct {
+		query string
+		bits  []uint64
+	}{
+		{query: `ColumnAttrs(country="DE")`, bits: []uint64{1, 2, SliceWidth + 2}},
+		{query: `ColumnAttrs(country="DE", tier=2)`, bits: []uint64{SliceWidth + 2}},
+		{query: `Intersect(ColumnAttrs(country="DE"), Bitmap(frame=f, rowID=10))`, bits: []uint64{1, SliceWidth + 2}},
+		{query: `Union(ColumnAttrs(country="US"), ColumnAttrs(tier=1))`, bits: []uint64{1, 3}},
+		{query: `ColumnAttrs(country="FR")`, bits: []uint64{}},
+		{query: `RowAttrs(frame=f, owner="x")`, bits: []uint64{1, 5}},
+		{query: `RowAttrs(frame=f, owner="z")`, bits: []uint64{3*SliceWidth + 1}},
+		{query: `Union(RowAttrs(frame=f, owner="x"), RowAttrs(frame=f, owner="z"))`, bits: []uint64{1, 5, 3*SliceWidth + 1}},
+	} {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(tt.query), nil, nil); err != nil {
+			t.Fatalf("%s: %s", tt.query, err)
+		} else if bits := result[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, tt.bits) {
+			t.Fatalf("%s: unexpected bits: %v", tt.query, bits)
+		}
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`ColumnAttrs()`), nil, nil); err == nil || err.Error() != "ColumnAttrs(): attribute required" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`RowAttrs(frame=f, owner=["x"])`), nil, nil); err == nil || err.Error() != `RowAttrs(): invalid value for "owner"` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 //Ensure TopN handles Attribute filters with source bitmap
 func TestExecutor_Execute_TopN_Attr_Src(t *testing.T) {
 	//
@@ -660,53 +1167,643 @@ func TestExecutor_Execute_Sum(t *testing.T) {
 	})
 }
 
-// Ensure a range query can be executed.
-func TestExecutor_Execute_Range(t *testing.T) {
+// Ensure a Min() and Max() query can be executed.
+func TestExecutor_Execute_MinMax(t *testing.T) {
 	hldr := test.MustOpenHolder()
 	defer hldr.Close()
 	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
 
-	// Create index.
-	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
 
-	// Create frame.
-	if _, err := index.CreateFrameIfNotExists("f", pilosa.FrameOptions{
-		InverseEnabled: true,
-		TimeQuantum:    pilosa.TimeQuantum("YMDH"),
+	if _, err := idx.CreateFrame("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields: []*pilosa.Field{
+			{Name: "foo", Type: pilosa.FieldTypeInt, Min: -10, Max: 100},
+		},
 	}); err != nil {
 		t.Fatal(err)
 	}
 
-	// Set bits.
 	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
-        SetBit(frame=f, rowID=1, columnID=2, timestamp="1999-12-31T00:00")
-        SetBit(frame=f, rowID=1, columnID=3, timestamp="2000-01-01T00:00")
-        SetBit(frame=f, rowID=1, columnID=4, timestamp="2000-01-02T00:00")
-        SetBit(frame=f, rowID=1, columnID=5, timestamp="2000-02-01T00:00")
-        SetBit(frame=f, rowID=1, columnID=6, timestamp="2001-01-01T00:00")
-        SetBit(frame=f, rowID=1, columnID=7, timestamp="2002-01-01T02:00")
+		SetBit(frame=f, rowID=0, columnID=0)
+		SetBit(frame=f, rowID=0, columnID=`+strconv.Itoa(SliceWidth+1)+`)
 
-        SetBit(frame=f, rowID=1, columnID=2, timestamp="1999-12-30T00:00")
-        SetBit(frame=f, rowID=1, columnID=2, timestamp="2002-02-01T00:00")
-        SetBit(frame=f, rowID=10, columnID=2, timestamp="2001-01-01T00:00")
+		SetFieldValue(frame=f, foo=20, columnID=0)
+		SetFieldValue(frame=f, foo=-5, columnID=`+strconv.Itoa(SliceWidth)+`)
+		SetFieldValue(frame=f, foo=-5, columnID=`+strconv.Itoa(SliceWidth+2)+`)
+		SetFieldValue(frame=f, foo=80, columnID=`+strconv.Itoa((5*SliceWidth)+100)+`)
+		SetFieldValue(frame=f, foo=60, columnID=`+strconv.Itoa(SliceWidth+1)+`)
+		SetFieldValue(frame=f, foo=80, columnID=`+strconv.Itoa((5*SliceWidth)+200)+`)
 	`), nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
-	t.Run("Standard", func(t *testing.T) {
-		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Range(rowID=1, frame=f, start="1999-12-31T00:00", end="2002-01-01T03:00")`), nil, nil); err != nil {
+	t.Run("Min", func(t *testing.T) {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(`Min(frame=f, field=foo)`), nil, nil); err != nil {
 			t.Fatal(err)
-		} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{2, 3, 4, 5, 6, 7}) {
-			t.Fatalf("unexpected bits: %+v", bits)
+		} else if !reflect.DeepEqual(result[0], pilosa.ValCount{Val: -5, Count: 2}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
 		}
 	})
 
-	t.Run("Inverse", func(t *testing.T) {
-		e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
-		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Range(columnID=2, frame=f, start="1999-01-01T00:00", end="2003-01-01T00:00")`), nil, nil); err != nil {
+	t.Run("MinWithFilter", func(t *testing.T) {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(`Min(Bitmap(frame=f, rowID=0), frame=f, field=foo)`), nil, nil); err != nil {
 			t.Fatal(err)
-		} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{1, 10}) {
-			t.Fatalf("unexpected bits: %+v", bits)
+		} else if !reflect.DeepEqual(result[0], pilosa.ValCount{Val: 20, Count: 1}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(`Max(frame=f, field=foo)`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result[0], pilosa.ValCount{Val: 80, Count: 2}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+
+	t.Run("MaxWithFilter", func(t *testing.T) {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(`Max(Bitmap(frame=f, rowID=0), frame=f, field=foo)`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result[0], pilosa.ValCount{Val: 60, Count: 1}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+
+	t.Run("ErrFrameRequired", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`Max(field=foo)`), nil, nil); err == nil || err.Error() != "Max(): frame required" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure a Percentile() query can be executed.
+func TestExecutor_Execute_Percentile(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := idx.CreateFrame("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields: []*pilosa.Field{
+			{Name: "foo", Type: pilosa.FieldTypeInt, Min: -10, Max: 100},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
+		SetBit(frame=f, rowID=0, columnID=0)
+		SetBit(frame=f, rowID=0, columnID=`+strconv.Itoa(SliceWidth+1)+`)
+
+		SetFieldValue(frame=f, foo=20, columnID=0)
+		SetFieldValue(frame=f, foo=-5, columnID=`+strconv.Itoa(SliceWidth)+`)
+		SetFieldValue(frame=f, foo=-5, columnID=`+strconv.Itoa(SliceWidth+2)+`)
+		SetFieldValue(frame=f, foo=80, columnID=`+strconv.Itoa((5*SliceWidth)+100)+`)
+		SetFieldValue(frame=f, foo=60, columnID=`+strconv.Itoa(SliceWidth+1)+`)
+		SetFieldValue(frame=f, foo=80, columnID=`+strconv.Itoa((5*SliceWidth)+200)+`)
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		q   string
+		exp pilosa.ValCount
+	}{
+		{`Percentile(frame=f, field=foo, nth=0)`, pilosa.ValCount{Val: -5, Count: 2}},
+		{`Percentile(frame=f, field=foo, nth=50)`, pilosa.ValCount{Val: 20, Count: 1}},
+		{`Percentile(frame=f, field=foo, nth=67.5)`, pilosa.ValCount{Val: 80, Count: 2}},
+		{`Percentile(frame=f, field=foo, nth=100)`, pilosa.ValCount{Val: 80, Count: 2}},
+		{`Percentile(Bitmap(frame=f, rowID=0), frame=f, field=foo, nth=50)`, pilosa.ValCount{Val: 20, Count: 1}},
+		{`Percentile(Bitmap(frame=f, rowID=0), frame=f, field=foo, nth=99)`, pilosa.ValCount{Val: 60, Count: 1}},
+		{`Percentile(Bitmap(frame=f, rowID=100), frame=f, field=foo, nth=50)`, pilosa.ValCount{}},
+	} {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(tt.q), nil, nil); err != nil {
+			t.Fatalf("%s: %s", tt.q, err)
+		} else if !reflect.DeepEqual(result[0], tt.exp) {
+			t.Fatalf("%s: unexpected result: %s", tt.q, spew.Sdump(result))
+		}
+	}
+
+	t.Run("ErrNthRequired", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`Percentile(frame=f, field=foo)`), nil, nil); err == nil || err.Error() != "Percentile(): nth required" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrNthOutOfRange", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`Percentile(frame=f, field=foo, nth=101)`), nil, nil); err == nil || err.Error() != "Percentile(): nth must be between 0 and 100" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure decimal field values can be set, filtered and aggregated.
+func TestExecutor_Execute_DecimalField(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := idx.CreateFrame("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields: []*pilosa.Field{
+			{Name: "price", Type: pilosa.FieldTypeDecimal, Scale: 2, Min: -100000, Max: 100000},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
+		SetFieldValue(frame=f, price=1.25, columnID=1)
+		SetFieldValue(frame=f, price=0.1, columnID=2)
+		SetFieldValue(frame=f, price=-3, columnID=3)
+		SetFieldValue(frame=f, price="999.99", columnID=`+strconv.Itoa(SliceWidth+1)+`)
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Range", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			bits  []uint64
+		}{
+			{query: `Range(frame=f, price > 0.1)`, bits: []uint64{1, SliceWidth + 1}},
+			{query: `Range(frame=f, price >= 0.1)`, bits: []uint64{1, 2, SliceWidth + 1}},
+			{query: `Range(frame=f, price == 1.25)`, bits: []uint64{1}},
+			{query: `Range(frame=f, price < 0)`, bits: []uint64{3}},
+			{query: `Range(frame=f, price >< [-3, 1.25])`, bits: []uint64{1, 2, 3}},
+			{query: `Range(frame=f, price > "999.98")`, bits: []uint64{SliceWidth + 1}},
+		} {
+			if result, err := e.Execute(context.Background(), "i", test.MustParse(tt.query), nil, nil); err != nil {
+				t.Fatalf("%s: %s", tt.query, err)
+			} else if bits := result[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, tt.bits) {
+				t.Fatalf("%s: unexpected bits: %v", tt.query, bits)
+			}
+		}
+	})
+
+	t.Run("Sum", func(t *testing.T) {
+		result, err := e.Execute(context.Background(), "i", test.MustParse(`Sum(frame=f, field=price)`), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result[0], pilosa.SumCount{Sum: 99834, Count: 4, Scale: 2}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		} else if buf, err := json.Marshal(result[0]); err != nil {
+			t.Fatal(err)
+		} else if string(buf) != `{"sum":998.34,"count":4}` {
+			t.Fatalf("unexpected json: %s", buf)
+		}
+	})
+
+	t.Run("MinMax", func(t *testing.T) {
+		result, err := e.Execute(context.Background(), "i", test.MustParse(`Min(frame=f, field=price) Max(frame=f, field=price)`), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		} else if buf, err := json.Marshal(result); err != nil {
+			t.Fatal(err)
+		} else if string(buf) != `[{"value":-3.00,"count":1},{"value":999.99,"count":1}]` {
+			t.Fatalf("unexpected json: %s", buf)
+		}
+	})
+
+	t.Run("Percentile", func(t *testing.T) {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(`Percentile(frame=f, field=price, nth=50)`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result[0], pilosa.ValCount{Val: 10, Count: 1, Scale: 2}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+
+	t.Run("ErrFieldValuePrecision", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`SetFieldValue(frame=f, price=1.125, columnID=4)`), nil, nil); err != pilosa.ErrFieldValuePrecision {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := e.Execute(context.Background(), "i", test.MustParse(`Range(frame=f, price > 1.001)`), nil, nil); err != pilosa.ErrFieldValuePrecision {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure timestamp fields accept RFC3339 values.
+func TestExecutor_Execute_TimestampField(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := idx.CreateFrame("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields: []*pilosa.Field{
+			{Name: "last_seen", Type: pilosa.FieldTypeTimestamp, Unit: pilosa.TimeUnitSeconds, Min: 946684800, Max: 4102444800},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
+		SetFieldValue(frame=f, last_seen="2016-12-31T23:59", columnID=1)
+		SetFieldValue(frame=f, last_seen="2017-01-01T00:00:00Z", columnID=2)
+		SetFieldValue(frame=f, last_seen=1500000000, columnID=3)
+		SetFieldValue(frame=f, last_seen="2018-06-01T12:00:00+02:00", columnID=`+strconv.Itoa(SliceWidth+1)+`)
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Range", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			bits  []uint64
+		}{
+			{query: `Range(frame=f, last_seen > "2017-01-01T00:00")`, bits: []uint64{3, SliceWidth + 1}},
+			{query: `Range(frame=f, last_seen >= "2017-01-01T00:00")`, bits: []uint64{2, 3, SliceWidth + 1}},
+			{query: `Range(frame=f, last_seen == "2017-07-14T02:40:00Z")`, bits: []uint64{3}},
+			{query: `Range(frame=f, last_seen < "2017-01-01")`, bits: []uint64{1}},
+			{query: `Range(frame=f, last_seen >< ["2017-01-01T00:00", "2018-06-01T10:00"])`, bits: []uint64{2, 3, SliceWidth + 1}},
+		} {
+			if result, err := e.Execute(context.Background(), "i", test.MustParse(tt.query), nil, nil); err != nil {
+				t.Fatalf("%s: %s", tt.query, err)
+			} else if bits := result[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, tt.bits) {
+				t.Fatalf("%s: unexpected bits: %v", tt.query, bits)
+			}
+		}
+	})
+
+	t.Run("ErrFieldValuePrecision", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`SetFieldValue(frame=f, last_seen="2017-01-01T00:00:00.5Z", columnID=4)`), nil, nil); err != pilosa.ErrFieldValuePrecision {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure a GroupBy() query can be executed.
+func TestExecutor_Execute_GroupBy(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	hldr.MustCreateFrameIfNotExists("i", "a")
+	hldr.MustCreateFrameIfNotExists("i", "b")
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
+		SetBit(frame=a, rowID=1, columnID=0)
+		SetBit(frame=a, rowID=1, columnID=1)
+		SetBit(frame=a, rowID=1, columnID=2)
+		SetBit(frame=a, rowID=1, columnID=`+strconv.Itoa(SliceWidth+1)+`)
+		SetBit(frame=a, rowID=2, columnID=3)
+
+		SetBit(frame=b, rowID=10, columnID=0)
+		SetBit(frame=b, rowID=10, columnID=1)
+		SetBit(frame=b, rowID=10, columnID=3)
+		SetBit(frame=b, rowID=10, columnID=`+strconv.Itoa(SliceWidth+1)+`)
+		SetBit(frame=b, rowID=11, columnID=2)
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Single", func(t *testing.T) {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(`GroupBy(Rows(frame=a))`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result[0], []pilosa.GroupCount{
+			{Group: []uint64{1}, Count: 4},
+			{Group: []uint64{2}, Count: 1},
+		}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+
+	t.Run("Multiple", func(t *testing.T) {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(`GroupBy(Rows(frame=a), Rows(frame=b))`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result[0], []pilosa.GroupCount{
+			{Group: []uint64{1, 10}, Count: 3},
+			{Group: []uint64{1, 11}, Count: 1},
+			{Group: []uint64{2, 10}, Count: 1},
+		}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+
+	t.Run("WithFilter", func(t *testing.T) {
+		if result, err := e.Execute(context.Background(), "i", test.MustParse(`GroupBy(Rows(frame=a), Rows(frame=b), filter=Bitmap(frame=b, rowID=11))`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result[0], []pilosa.GroupCount{
+			{Group: []uint64{1, 11}, Count: 1},
+		}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+
+	t.Run("ErrRowsRequired", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`GroupBy(Bitmap(frame=a, rowID=1))`), nil, nil); err == nil || err.Error() != "GroupBy() only accepts Rows() inputs, found Bitmap()" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure string keys can be used for rows & columns.
+func TestExecutor_Execute_Keys(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{Keys: true})
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err := idx.CreateFrame("f", pilosa.FrameOptions{Keys: true}); err != nil {
+		t.Fatal(err)
+	} else if _, err := idx.CreateFrame("g", pilosa.FrameOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
+		SetBit(frame=f, row="chrome", col="user-a")
+		SetBit(frame=f, row="chrome", col="user-b")
+		SetBit(frame=f, row="firefox", col="user-b")
+		SetBit(frame=g, rowID=10, col="user-c")
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Bitmap", func(t *testing.T) {
+		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=f, row="chrome")`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{1, 2}) {
+			t.Fatalf("unexpected bits: %+v", bits)
+		} else if keys := res[0].(*pilosa.Bitmap).Keys; !reflect.DeepEqual(keys, []string{"user-a", "user-b"}) {
+			t.Fatalf("unexpected keys: %+v", keys)
+		}
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Union(Bitmap(frame=f, row="firefox"), Bitmap(frame=g, rowID=10))`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if keys := res[0].(*pilosa.Bitmap).Keys; !reflect.DeepEqual(keys, []string{"user-b", "user-c"}) {
+			t.Fatalf("unexpected keys: %+v", keys)
+		}
+	})
+
+	t.Run("TopN", func(t *testing.T) {
+		hldr.RecalculateCaches()
+		if res, err := e.Execute(context.Background(), "i", test.MustParse(`TopN(frame=f)`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(res[0], []pilosa.Pair{
+			{ID: 1, Key: "chrome", Count: 2},
+			{ID: 2, Key: "firefox", Count: 1},
+		}) {
+			t.Fatalf("unexpected pairs: %s", spew.Sdump(res))
+		}
+	})
+
+	t.Run("ErrFrameKeysNotEnabled", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=g, row="x")`), nil, nil); err != pilosa.ErrFrameKeysNotEnabled {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	// Reads of unknown keys must not allocate IDs for them.
+	t.Run("ErrTranslateKeyNotFound", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=f, row="safari")`), nil, nil); err != pilosa.ErrTranslateKeyNotFound {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := e.Execute(context.Background(), "i", test.MustParse(`Count(Bitmap(frame=g, col="user-z"))`), nil, nil); err != pilosa.ErrTranslateKeyNotFound {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if ids, err := idx.Frame("f").TranslateStore().LookupKeys([]string{"safari"}); err != nil {
+			t.Fatal(err)
+		} else if ids[0] != 0 {
+			t.Fatalf("unexpected row id: %d", ids[0])
+		} else if ids, err := idx.TranslateStore().LookupKeys([]string{"user-z"}); err != nil {
+			t.Fatal(err)
+		} else if ids[0] != 0 {
+			t.Fatalf("unexpected column id: %d", ids[0])
+		}
+	})
+
+	t.Run("ErrTranslatorNotConfigured", func(t *testing.T) {
+		e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+		e.Translator = nil
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=f, row="chrome")`), nil, nil); err != pilosa.ErrTranslatorNotConfigured {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=g, rowID=10)`), nil, nil); err != pilosa.ErrTranslatorNotConfigured {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure a ClearRow() call clears a row from all slices & views.
+func TestExecutor_Execute_ClearRow(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	if _, err := idx.CreateFrameIfNotExists("f", pilosa.FrameOptions{
+		InverseEnabled: true,
+		TimeQuantum:    pilosa.TimeQuantum("Y"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(fmt.Sprintf(`
+		SetBit(frame=f, rowID=10, columnID=3)
+		SetBit(frame=f, rowID=10, columnID=%d, timestamp="2000-01-01T00:00")
+		SetBit(frame=f, rowID=11, columnID=3)
+	`, SliceWidth+1)), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`ClearRow(frame=f, rowID=10)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if !res[0].(bool) {
+		t.Fatal("expected change")
+	}
+
+	// Verify the row is cleared from the standard & time views.
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`
+		Bitmap(frame=f, rowID=10)
+		Range(frame=f, rowID=10, start="1999-01-01T00:00", end="2002-01-01T00:00")
+		Bitmap(frame=f, rowID=11)
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); len(bits) != 0 {
+		t.Fatalf("unexpected bits: %v", bits)
+	} else if bits := res[1].(*pilosa.Bitmap).Bits(); len(bits) != 0 {
+		t.Fatalf("unexpected time bits: %v", bits)
+	} else if bits := res[2].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{3}) {
+		t.Fatalf("unexpected neighbor bits: %v", bits)
+	}
+
+	// Verify the inverse view is consistent.
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=f, columnID=3)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{11}) {
+		t.Fatalf("unexpected inverse bits: %v", bits)
+	}
+
+	// Clearing again has no effect.
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`ClearRow(frame=f, rowID=10)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if res[0].(bool) {
+		t.Fatal("expected no change")
+	}
+}
+
+// Ensure a Store() call writes its input into a row.
+func TestExecutor_Execute_Store(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	hldr.Holder.LogOutput = &lockedWriter{w: ioutil.Discard}
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	if _, err := idx.CreateFrameIfNotExists("segments", pilosa.FrameOptions{
+		InverseEnabled: true,
+		CacheType:      pilosa.CacheTypeRanked,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).MustSetBits(10, 1, 2, 3)
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).MustSetBits(11, 2, 3, 4)
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1).MustSetBits(10, SliceWidth+1)
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1).MustSetBits(11, SliceWidth+1)
+
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`Store(Intersect(Bitmap(frame=f, rowID=10), Bitmap(frame=f, rowID=11)), frame=segments, rowID=42)`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify the stored row.
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=segments, rowID=42)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{2, 3, SliceWidth + 1}) {
+		t.Fatalf("unexpected bits: %+v", bits)
+	}
+
+	// Verify the inverse view was written.
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=segments, columnID=3)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{42}) {
+		t.Fatalf("unexpected inverse bits: %+v", bits)
+	}
+
+	// Verify the rank cache was refreshed.
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`TopN(frame=segments, n=1)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if pairs := res[0].([]pilosa.Pair); !reflect.DeepEqual(pairs, []pilosa.Pair{{ID: 42, Count: 3}}) {
+		t.Fatalf("unexpected pairs: %+v", pairs)
+	}
+
+	// Storing again replaces the row and its inverse bits.
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).MustSetBits(12, 4)
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`Store(Bitmap(frame=f, rowID=12), frame=segments, rowID=42)`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=segments, rowID=42)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{4}) {
+		t.Fatalf("unexpected bits after overwrite: %+v", bits)
+	}
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=segments, columnID=3)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if bits := res[0].(*pilosa.Bitmap).Bits(); len(bits) != 0 {
+		t.Fatalf("unexpected inverse bits after overwrite: %+v", bits)
+	}
+
+	// Time views are not rewritten by Store() so the row's history is kept.
+	t.Run("TimeViews", func(t *testing.T) {
+		if _, err := idx.CreateFrameIfNotExists("history", pilosa.FrameOptions{TimeQuantum: pilosa.TimeQuantum("D")}); err != nil {
+			t.Fatal(err)
+		} else if _, err := e.Execute(context.Background(), "i", test.MustParse(`SetBit(frame=history, rowID=7, columnID=5, timestamp="2000-01-01T00:00")`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if _, err := e.Execute(context.Background(), "i", test.MustParse(`Store(Bitmap(frame=f, rowID=12), frame=history, rowID=7)`), nil, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(frame=history, rowID=7)`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{4}) {
+			t.Fatalf("unexpected bits: %+v", bits)
+		}
+		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Range(frame=history, rowID=7, start="2000-01-01T00:00", end="2000-01-02T00:00")`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{5}) {
+			t.Fatalf("unexpected time range bits: %+v", bits)
+		}
+	})
+
+	t.Run("ErrInputRequired", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`Store(frame=segments, rowID=42)`), nil, nil); err == nil || err.Error() != "Store() requires a single bitmap input" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrFrameNotFound", func(t *testing.T) {
+		if _, err := e.Execute(context.Background(), "i", test.MustParse(`Store(Bitmap(frame=f, rowID=10), frame=x, rowID=42)`), nil, nil); err != pilosa.ErrFrameNotFound {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure a range query can be executed.
+func TestExecutor_Execute_Range(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	// Create index.
+	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+
+	// Create frame.
+	if _, err := index.CreateFrameIfNotExists("f", pilosa.FrameOptions{
+		InverseEnabled: true,
+		TimeQuantum:    pilosa.TimeQuantum("YMDH"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Set bits.
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`
+        SetBit(frame=f, rowID=1, columnID=2, timestamp="1999-12-31T00:00")
+        SetBit(frame=f, rowID=1, columnID=3, timestamp="2000-01-01T00:00")
+        SetBit(frame=f, rowID=1, columnID=4, timestamp="2000-01-02T00:00")
+        SetBit(frame=f, rowID=1, columnID=5, timestamp="2000-02-01T00:00")
+        SetBit(frame=f, rowID=1, columnID=6, timestamp="2001-01-01T00:00")
+        SetBit(frame=f, rowID=1, columnID=7, timestamp="2002-01-01T02:00")
+
+        SetBit(frame=f, rowID=1, columnID=2, timestamp="1999-12-30T00:00")
+        SetBit(frame=f, rowID=1, columnID=2, timestamp="2002-02-01T00:00")
+        SetBit(frame=f, rowID=10, columnID=2, timestamp="2001-01-01T00:00")
+	`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Standard", func(t *testing.T) {
+		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Range(rowID=1, frame=f, start="1999-12-31T00:00", end="2002-01-01T03:00")`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{2, 3, 4, 5, 6, 7}) {
+			t.Fatalf("unexpected bits: %+v", bits)
+		}
+	})
+
+	t.Run("Inverse", func(t *testing.T) {
+		e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+		if res, err := e.Execute(context.Background(), "i", test.MustParse(`Range(columnID=2, frame=f, start="1999-01-01T00:00", end="2003-01-01T00:00")`), nil, nil); err != nil {
+			t.Fatal(err)
+		} else if bits := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(bits, []uint64{1, 10}) {
+			t.Fatalf("unexpected bits: %+v", bits)
 		}
 	})
 }
@@ -863,6 +1960,197 @@ func TestExecutor_Execute_FieldRange(t *testing.T) {
 	})
 }
 
+// Ensure field queries return consistent results while the field is re-ranged.
+func TestExecutor_Execute_FieldRange_Rerange(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	f, err := idx.CreateFrame("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields: []*pilosa.Field{
+			{Name: "foo", Type: pilosa.FieldTypeInt, Min: 10, Max: 100},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range []int64{10, 20, 30, 40, 50, 60} {
+		if _, err := f.SetFieldValue(uint64(i)*SliceWidth/2, "foo", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Query the field until all re-ranges have been committed.
+	done := make(chan struct{})
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			if result, err := e.Execute(context.Background(), "i", test.MustParse(`Sum(frame=f, field=foo) Count(Range(frame=f, foo >= 35)) Max(frame=f, field=foo)`), nil, nil); err != nil {
+				errs <- err
+				return
+			} else if sum := result[0].(pilosa.SumCount); sum.Sum != 210 || sum.Count != 6 {
+				errs <- fmt.Errorf("unexpected sum: %#v", sum)
+				return
+			} else if n := result[1].(uint64); n != 3 {
+				errs <- fmt.Errorf("unexpected count: %d", n)
+				return
+			} else if max := result[2].(pilosa.ValCount); max.Val != 60 || max.Count != 1 {
+				errs <- fmt.Errorf("unexpected max: %#v", max)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		min, max := int64(-1000), int64(100000)
+		if i%2 == 1 {
+			min, max = 10, 100
+		}
+		if err := f.RerangeField("foo", min, max); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(done)
+
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Ensure the executor can explain a query without executing it.
+func TestExecutor_Explain(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	if _, err := index.CreateFrameIfNotExists("f", pilosa.FrameOptions{TimeQuantum: pilosa.TimeQuantum("YM")}); err != nil {
+		t.Fatal(err)
+	}
+	hldr.MustCreateFragmentIfNotExists("i", "g", pilosa.ViewStandard, 0).MustSetBits(10, 1)
+	hldr.MustCreateFragmentIfNotExists("i", "g", pilosa.ViewStandard, 1).MustSetBits(10, SliceWidth+1)
+
+	t.Run("Range", func(t *testing.T) {
+		plans, err := e.Explain(context.Background(), "i", test.MustParse(`Count(Intersect(Range(rowID=1, frame=f, start="1999-12-01T00:00", end="2000-03-01T00:00"), Bitmap(frame=g, rowID=10)))`), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		} else if len(plans) != 1 {
+			t.Fatalf("unexpected plan count: %d", len(plans))
+		}
+
+		plan := plans[0]
+		if !reflect.DeepEqual(plan.Views, []*pilosa.ViewPlan{
+			{Call: `Range(end="2000-03-01T00:00", frame="f", rowID=1, start="1999-12-01T00:00")`, Frame: "f", Views: []string{"standard_199912", "standard_200001", "standard_200002"}},
+			{Call: `Bitmap(frame="g", rowID=10)`, Frame: "g", Views: []string{"standard"}},
+		}) {
+			t.Fatalf("unexpected views: %s", spew.Sdump(plan.Views))
+		}
+
+		if len(plan.Nodes) != 1 {
+			t.Fatalf("unexpected node count: %d", len(plan.Nodes))
+		} else if node := plan.Nodes[0]; node.Host != e.Host {
+			t.Fatalf("unexpected host: %s", node.Host)
+		} else if !reflect.DeepEqual(node.Slices, []uint64{0, 1}) {
+			t.Fatalf("unexpected slices: %v", node.Slices)
+		} else if len(node.MissingFragments) != 6 {
+			t.Fatalf("unexpected missing fragments: %s", spew.Sdump(node.MissingFragments))
+		} else if !reflect.DeepEqual(node.MissingFragments[0], &pilosa.FragmentPlan{Frame: "f", View: "standard_199912", Slice: 0}) {
+			t.Fatalf("unexpected missing fragment: %s", spew.Sdump(node.MissingFragments[0]))
+		}
+	})
+
+	// Writes are not planned or executed.
+	t.Run("Write", func(t *testing.T) {
+		plans, err := e.Explain(context.Background(), "i", test.MustParse(`SetBit(frame=f, rowID=1, columnID=2)`), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(plans, []*pilosa.CallPlan{{Call: `SetBit(columnID=2, frame="f", rowID=1)`}}) {
+			t.Fatalf("unexpected plans: %s", spew.Sdump(plans))
+		} else if hldr.Fragment("i", "f", pilosa.ViewStandard, 0) != nil {
+			t.Fatal("expected write to be skipped")
+		}
+	})
+}
+
+// Ensure the executor can explain a query across remote nodes.
+func TestExecutor_Explain_Remote(t *testing.T) {
+	c := test.NewCluster(2)
+
+	// Create secondary server and update second cluster node.
+	s := test.NewServer()
+	defer s.Close()
+	c.Nodes[1].Host = s.Host()
+
+	// Mock secondary server's executor to report all fragments as missing.
+	var remoteSlices []uint64
+	s.Handler.Executor.ExplainFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]*pilosa.CallPlan, error) {
+		if !opt.Remote {
+			t.Fatal("expected remote option")
+		} else if query.String() != `Bitmap(frame="f", rowID=10)` {
+			t.Fatalf("unexpected query: %s", query.String())
+		}
+		remoteSlices = slices
+
+		node := &pilosa.NodePlan{Host: s.Host(), Slices: slices}
+		for _, slice := range slices {
+			node.MissingFragments = append(node.MissingFragments, &pilosa.FragmentPlan{Frame: "f", View: pilosa.ViewStandard, Slice: slice})
+		}
+		return []*pilosa.CallPlan{{Call: query.String(), Nodes: []*pilosa.NodePlan{node}}}, nil
+	}
+
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0)
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1)
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 2)
+
+	e := test.NewExecutor(hldr.Holder, c)
+	plans, err := e.Explain(context.Background(), "i", test.MustParse(`Bitmap(rowID=10, frame=f)`), []uint64{0, 1, 2}, nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(plans[0].Nodes) != 2 {
+		t.Fatalf("unexpected nodes: %s", spew.Sdump(plans[0].Nodes))
+	}
+
+	for _, node := range plans[0].Nodes {
+		if node.Host == e.Host {
+			if len(node.MissingFragments) != 0 {
+				t.Fatalf("unexpected local missing fragments: %s", spew.Sdump(node.MissingFragments))
+			}
+		} else if node.Host != s.Host() {
+			t.Fatalf("unexpected host: %s", node.Host)
+		} else if !reflect.DeepEqual(node.Slices, remoteSlices) {
+			t.Fatalf("unexpected remote slices: %v != %v", node.Slices, remoteSlices)
+		} else if len(node.MissingFragments) != len(remoteSlices) {
+			t.Fatalf("unexpected remote missing fragments: %s", spew.Sdump(node.MissingFragments))
+		}
+	}
+}
+
+// Ensure a canceled query stops executing.
+func TestExecutor_Execute_Canceled(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0).MustSetBits(10, 1)
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1).MustSetBits(10, SliceWidth+1)
+	e := test.NewExecutor(hldr.Holder, test.NewCluster(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := e.Execute(ctx, "i", test.MustParse(`Count(Bitmap(rowID=10, frame=f))`), nil, nil); err != context.Canceled {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 // Ensure a remote query can return a bitmap.
 func TestExecutor_Execute_Remote_Bitmap(t *testing.T) {
 	c := test.NewCluster(2)
@@ -907,6 +2195,154 @@ func TestExecutor_Execute_Remote_Bitmap(t *testing.T) {
 	}
 }
 
+// Ensure a profiled query records local slices & remote sub-profiles.
+func TestExecutor_Execute_Remote_Profile(t *testing.T) {
+	c := test.NewCluster(2)
+
+	// Create secondary server and update second cluster node.
+	s := test.NewServer()
+	defer s.Close()
+	c.Nodes[1].Host = s.Host()
+
+	// Mock secondary server's executor to verify profiling is requested.
+	s.Handler.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		if pilosa.ProfileFromContext(ctx) == nil {
+			t.Fatal("expected profile")
+		}
+		return []interface{}{pilosa.NewBitmap((2*SliceWidth)+4, (2*SliceWidth)+5)}, nil
+	}
+
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 1).MustSetBits(10, (1*SliceWidth)+1)
+
+	e := test.NewExecutor(hldr.Holder, c)
+	prof := pilosa.NewProfile("query", "")
+	res, err := e.Execute(pilosa.WithProfile(context.Background(), prof), "i", test.MustParse(`Bitmap(rowID=10, frame=f)`), []uint64{0, 1, 2}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(prof.Children) != 1 {
+		t.Fatalf("unexpected children: %s", spew.Sdump(prof.Children))
+	}
+	call := prof.Children[0]
+	if call.Name != "call" || call.Call != `Bitmap(frame="f", rowID=10)` || call.Host != e.Host {
+		t.Fatalf("unexpected call profile: %s", spew.Sdump(call))
+	} else if n := res[0].(*pilosa.Bitmap).Count(); call.Count != n {
+		t.Fatalf("unexpected count: %d != %d", call.Count, n)
+	}
+
+	// Verify local slices & the remote step were recorded.
+	var sliceN int
+	var remote *pilosa.Profile
+	for _, child := range call.Children {
+		switch child.Name {
+		case "slice":
+			sliceN++
+		case "remote":
+			remote = child
+		}
+	}
+	if sliceN == 0 {
+		t.Fatal("expected slice profiles")
+	} else if remote == nil {
+		t.Fatal("expected remote profile")
+	} else if remote.Host != s.Host() || remote.Count != 2 {
+		t.Fatalf("unexpected remote profile: %s", spew.Sdump(remote))
+	} else if len(remote.Children) != 1 || remote.Children[0].Name != "query" || remote.Children[0].Host != s.Host() {
+		t.Fatalf("unexpected remote sub-profile: %s", spew.Sdump(remote.Children))
+	} else if len(remote.Children[0].Children) != 1 || remote.Children[0].Children[0].Name != "parse" {
+		t.Fatalf("unexpected remote sub-profile children: %s", spew.Sdump(remote.Children[0].Children))
+	}
+}
+
+// Ensure a remote Store() is forwarded with the remote node's slices.
+func TestExecutor_Execute_Remote_Store(t *testing.T) {
+	c := test.NewCluster(2)
+
+	// Create secondary server and update second cluster node.
+	s := test.NewServer()
+	defer s.Close()
+	c.Nodes[1].Host = s.Host()
+
+	// Report the remote request back to the test goroutine.
+	type remoteCall struct {
+		query  string
+		remote bool
+		slices []uint64
+	}
+	remoteCalls := make(chan remoteCall, 1)
+	s.Handler.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		remoteCalls <- remoteCall{query: query.String(), remote: opt.Remote, slices: slices}
+		return []interface{}{nil}, nil
+	}
+
+	// Slices are stored concurrently so the log output must be goroutine-safe.
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	hldr.Holder.LogOutput = &lockedWriter{w: ioutil.Discard}
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	if _, err := idx.CreateFrameIfNotExists("s", pilosa.FrameOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for slice := uint64(0); slice < 4; slice++ {
+		hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, slice).MustSetBits(10, (slice*SliceWidth)+1)
+	}
+
+	e := test.NewExecutor(hldr.Holder, c)
+	if _, err := e.Execute(context.Background(), "i", test.MustParse(`Store(Bitmap(frame=f, rowID=10), frame=s, rowID=42)`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var remoteSlices []uint64
+	select {
+	case call := <-remoteCalls:
+		if call.query != `Store(Bitmap(frame="f", rowID=10), frame="s", rowID=42)` {
+			t.Fatalf("unexpected query: %s", call.query)
+		} else if !call.remote {
+			t.Fatal("expected remote option")
+		}
+		remoteSlices = call.slices
+	default:
+		t.Fatal("expected remote call")
+	}
+
+	// Verify each slice was stored by its owner.
+	for slice := uint64(0); slice < 4; slice++ {
+		frag := hldr.Fragment("i", "s", pilosa.ViewStandard, slice)
+		if c.OwnsFragment(e.Host, "i", slice) {
+			if frag == nil {
+				t.Fatalf("expected local fragment for slice %d", slice)
+			} else if bits := frag.Row(42).Bits(); !reflect.DeepEqual(bits, []uint64{(slice * SliceWidth) + 1}) {
+				t.Fatalf("unexpected bits for slice %d: %v", slice, bits)
+			}
+		} else if frag != nil {
+			t.Fatalf("unexpected local fragment for slice %d", slice)
+		}
+
+		var found bool
+		for _, other := range remoteSlices {
+			found = found || other == slice
+		}
+		if c.OwnsFragment(s.Host(), "i", slice) != found {
+			t.Fatalf("unexpected remote slices: %v", remoteSlices)
+		}
+	}
+}
+
+// lockedWriter serializes writes to an underlying writer.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.w.Write(p)
+}
+
 // Ensure a remote query can return a count.
 func TestExecutor_Execute_Remote_Count(t *testing.T) {
 	c := test.NewCluster(2)
@@ -936,6 +2372,139 @@ func TestExecutor_Execute_Remote_Count(t *testing.T) {
 	}
 }
 
+// Ensure paginated bitmap queries fetch remote slices in batches.
+func TestExecutor_Execute_Remote_Bitmap_Pagination(t *testing.T) {
+	c := test.NewCluster(2)
+
+	// Create secondary server and update second cluster node.
+	s := test.NewServer()
+	defer s.Close()
+	c.Nodes[1].Host = s.Host()
+
+	// Mock secondary server's executor to return no bits & count requests.
+	var requestN int32
+	s.Handler.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		atomic.AddInt32(&requestN, 1)
+		return []interface{}{pilosa.NewBitmap()}, nil
+	}
+
+	// Set a bit in every slice. Only the locally owned slices are returned.
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	s.Handler.Holder = hldr.Holder
+	for slice := uint64(0); slice < 64; slice++ {
+		hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, slice).MustSetBits(10, slice*SliceWidth+1)
+	}
+
+	e := test.NewExecutor(hldr.Holder, c)
+	res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(rowID=10, frame=f)`), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bits := res[0].(*pilosa.Bitmap).Bits()
+	if len(bits) == 0 {
+		t.Fatal("expected local bits")
+	}
+
+	// Page to the last bit.
+	atomic.StoreInt32(&requestN, 0)
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Bitmap(rowID=10, frame=f)`), nil, &pilosa.ExecOptions{Limit: 1, Offset: uint64(len(bits) - 1)}); err != nil {
+		t.Fatal(err)
+	} else if other := res[0].(*pilosa.Bitmap).Bits(); !reflect.DeepEqual(other, bits[len(bits)-1:]) {
+		t.Fatalf("unexpected bits: %v", other)
+	} else if n := atomic.LoadInt32(&requestN); n > 7 {
+		t.Fatalf("unexpected remote request count: %d", n)
+	}
+}
+
+// Ensure a remote query can return a min or max value.
+func TestExecutor_Execute_Remote_MinMax(t *testing.T) {
+	c := test.NewCluster(2)
+
+	// Create secondary server and update second cluster node.
+	s := test.NewServer()
+	defer s.Close()
+	c.Nodes[1].Host = s.Host()
+
+	// Mock secondary server's executor to return a value & count.
+	s.Handler.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		if query.Calls[0].Name == "Min" {
+			return []interface{}{pilosa.ValCount{Val: 5, Count: 3}}, nil
+		}
+		return []interface{}{pilosa.ValCount{Val: 10, Count: 3}}, nil
+	}
+
+	// Create local executor data. The local node owns slice 2.
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	s.Handler.Holder = hldr.Holder
+
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	f, err := idx.CreateFrameIfNotExists("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields:       []*pilosa.Field{{Name: "foo", Type: pilosa.FieldTypeInt, Min: 0, Max: 100}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetFieldValue((2*SliceWidth)+1, "foo", 5); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetFieldValue((2*SliceWidth)+2, "foo", 20); err != nil {
+		t.Fatal(err)
+	}
+
+	e := test.NewExecutor(hldr.Holder, c)
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Min(frame=f, field=foo)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(res[0], pilosa.ValCount{Val: 5, Count: 4}) {
+		t.Fatalf("unexpected result: %s", spew.Sdump(res))
+	}
+
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`Max(frame=f, field=foo)`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(res[0], pilosa.ValCount{Val: 20, Count: 1}) {
+		t.Fatalf("unexpected result: %s", spew.Sdump(res))
+	}
+}
+
+// Ensure a remote query can return group counts.
+func TestExecutor_Execute_Remote_GroupBy(t *testing.T) {
+	c := test.NewCluster(2)
+
+	// Create secondary server and update second cluster node.
+	s := test.NewServer()
+	defer s.Close()
+	c.Nodes[1].Host = s.Host()
+
+	// Mock secondary server's executor to verify arguments and return groups.
+	s.Handler.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		if query.String() != `GroupBy(Rows(frame="a"), Rows(frame="b"), filter=Bitmap(frame="a", rowID=1))` {
+			t.Fatalf("unexpected query: %s", query.String())
+		}
+		return []interface{}{[]pilosa.GroupCount{
+			{Group: []uint64{1, 10}, Count: 2},
+			{Group: []uint64{1, 20}, Count: 5},
+		}}, nil
+	}
+
+	// Create local executor data. The local node owns slice 2.
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	s.Handler.Holder = hldr.Holder
+
+	hldr.MustCreateRankedFragmentIfNotExists("i", "a", pilosa.ViewStandard, 2).MustSetBits(1, (2*SliceWidth)+1, (2*SliceWidth)+2)
+	hldr.MustCreateRankedFragmentIfNotExists("i", "b", pilosa.ViewStandard, 2).MustSetBits(10, (2*SliceWidth)+1, (2*SliceWidth)+2)
+
+	e := test.NewExecutor(hldr.Holder, c)
+	if res, err := e.Execute(context.Background(), "i", test.MustParse(`GroupBy(Rows(frame=a), Rows(frame=b), filter=Bitmap(frame=a, rowID=1))`), nil, nil); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(res[0], []pilosa.GroupCount{
+		{Group: []uint64{1, 10}, Count: 4},
+		{Group: []uint64{1, 20}, Count: 5},
+	}) {
+		t.Fatalf("unexpected result: %s", spew.Sdump(res))
+	}
+}
+
 // Ensure a remote query can set bits on multiple nodes.
 func TestExecutor_Execute_Remote_SetBit(t *testing.T) {
 	c := test.NewCluster(2)
@@ -0,0 +1,382 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pilosa/pilosa/internal"
+	"github.com/pilosa/pilosa/pql"
+)
+
+// CallPlan describes how a top-level call would be executed.
+type CallPlan struct {
+	// PQL representation of the call.
+	Call string `json:"call"`
+
+	// Frames & views read by the call and its children.
+	Views []*ViewPlan `json:"views,omitempty"`
+
+	// Slices assigned to each node.
+	Nodes []*NodePlan `json:"nodes,omitempty"`
+}
+
+// ViewPlan describes the views of a frame read by a single call.
+type ViewPlan struct {
+	Call  string   `json:"call"`
+	Frame string   `json:"frame"`
+	Views []string `json:"views"`
+}
+
+// NodePlan describes the slices a node would process for a call.
+type NodePlan struct {
+	Host             string          `json:"host"`
+	Slices           []uint64        `json:"slices"`
+	MissingFragments []*FragmentPlan `json:"missingFragments,omitempty"`
+}
+
+// FragmentPlan identifies a single fragment.
+type FragmentPlan struct {
+	Frame string `json:"frame"`
+	View  string `json:"view"`
+	Slice uint64 `json:"slice"`
+}
+
+// Explain returns a plan for each call in q describing which views would be
+// read and how slices would be distributed across the cluster. The query is
+// not executed.
+func (e *Executor) Explain(ctx context.Context, index string, q *pql.Query, slices []uint64, opt *ExecOptions) ([]*CallPlan, error) {
+	// Verify that an index is set.
+	if index == "" {
+		return nil, ErrIndexRequired
+	}
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return nil, ErrIndexNotFound
+	}
+
+	// Default options.
+	if opt == nil {
+		opt = &ExecOptions{}
+	}
+
+	plans := make([]*CallPlan, len(q.Calls))
+	for i, c := range q.Calls {
+		plan, err := e.explainCall(ctx, idx, c, slices, opt)
+		if err != nil {
+			return nil, err
+		}
+		plans[i] = plan
+	}
+	return plans, nil
+}
+
+// explainCall returns the plan for a single top-level call.
+func (e *Executor) explainCall(ctx context.Context, idx *Index, c *pql.Call, slices []uint64, opt *ExecOptions) (*CallPlan, error) {
+	plan := &CallPlan{Call: c.String()}
+
+	// Writes are sent directly to the owners of a single slice.
+	switch c.Name {
+	case "SetBit", "ClearBit", "ClearRow", "SetFieldValue", "SetRowAttrs", "SetColumnAttrs":
+		return plan, nil
+	}
+
+	views, err := e.explainViews(idx, c)
+	if err != nil {
+		return nil, err
+	}
+	plan.Views = views
+
+	// Include all slices for the call's orientation, if unspecified.
+	if len(slices) == 0 {
+		maxSlice := idx.MaxSlice()
+		if e.isInverseCall(idx, c) {
+			maxSlice = idx.MaxInverseSlice()
+		}
+		slices = make([]uint64, maxSlice+1)
+		for i := range slices {
+			slices[i] = uint64(i)
+		}
+	}
+
+	// Determine nodes in the same way as mapReduce().
+	var nodes []*Node
+	if !opt.Remote {
+		nodes = Nodes(e.Cluster.Nodes).Clone()
+	} else {
+		nodes = []*Node{e.Cluster.NodeByHost(e.Host)}
+	}
+
+	m, err := e.slicesByNode(nodes, idx.Name(), slices)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range nodes {
+		nodeSlices, ok := m[node]
+		if !ok {
+			continue
+		}
+
+		nodePlan := &NodePlan{Host: node.Host, Slices: nodeSlices}
+		if node.Host == e.Host {
+			nodePlan.MissingFragments = e.missingFragments(idx, views, nodeSlices)
+		} else {
+			missing, err := e.explainRemote(ctx, node, idx.Name(), c, nodeSlices)
+			if err != nil {
+				return nil, err
+			}
+			nodePlan.MissingFragments = missing
+		}
+		plan.Nodes = append(plan.Nodes, nodePlan)
+	}
+
+	return plan, nil
+}
+
+// isInverseCall returns true if c is executed against the inverse slices.
+func (e *Executor) isInverseCall(idx *Index, c *pql.Call) bool {
+	if !c.SupportsInverse() {
+		return false
+	}
+
+	frame, _ := c.Args["frame"].(string)
+	if frame == "" {
+		frame = DefaultFrame
+	}
+	f := idx.Frame(frame)
+	if f == nil {
+		return false
+	}
+	return c.IsInverse(f.RowLabel(), idx.ColumnLabel())
+}
+
+// explainViews returns the frame views read by c and its children.
+func (e *Executor) explainViews(idx *Index, c *pql.Call) ([]*ViewPlan, error) {
+	var a []*ViewPlan
+
+	frame, _ := c.Args["frame"].(string)
+	if frame == "" {
+		frame = DefaultFrame
+	}
+
+	switch c.Name {
+	case "Bitmap":
+		view := ViewStandard
+		if e.isInverseCall(idx, c) {
+			view = ViewInverse
+		}
+		a = append(a, &ViewPlan{Call: c.String(), Frame: frame, Views: []string{view}})
+
+	case "Range":
+		views, err := e.explainRangeViews(idx, c, frame)
+		if err != nil {
+			return nil, err
+		}
+		a = append(a, &ViewPlan{Call: c.String(), Frame: frame, Views: views})
+
+	case "Not":
+		a = append(a, &ViewPlan{Call: c.String(), Frame: ExistenceDir, Views: []string{ViewStandard}})
+
+	case "TopN":
+		view := ViewStandard
+		if inverse, _ := c.Args["inverse"].(bool); inverse {
+			view = ViewInverse
+		}
+		f := idx.Frame(frame)
+		if f == nil {
+			return nil, ErrFrameNotFound
+		}
+		views, err := topNViews(f, view, c)
+		if err != nil {
+			return nil, err
+		}
+		a = append(a, &ViewPlan{Call: c.String(), Frame: frame, Views: views})
+
+	case "Rows":
+		a = append(a, &ViewPlan{Call: c.String(), Frame: frame, Views: []string{ViewStandard}})
+
+	case "Sum", "Min", "Max", "Percentile":
+		field, _ := c.Args["field"].(string)
+		a = append(a, &ViewPlan{Call: c.String(), Frame: frame, Views: []string{ViewFieldPrefix + field}})
+	}
+
+	// Include views read by children & call arguments.
+	for _, child := range c.Children {
+		other, err := e.explainViews(idx, child)
+		if err != nil {
+			return nil, err
+		}
+		a = append(a, other...)
+	}
+	for _, v := range c.Args {
+		if child, ok := v.(*pql.Call); ok {
+			other, err := e.explainViews(idx, child)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, other...)
+		}
+	}
+
+	return a, nil
+}
+
+// explainRangeViews returns the views read by a Range() call.
+func (e *Executor) explainRangeViews(idx *Index, c *pql.Call, frame string) ([]string, error) {
+	// Field ranges read a single field view.
+	if c.HasConditionArg() {
+		for k, v := range c.Args {
+			if _, ok := v.(*pql.Condition); ok {
+				return []string{ViewFieldPrefix + k}, nil
+			}
+		}
+	}
+
+	f := idx.Frame(frame)
+	if f == nil {
+		return nil, ErrFrameNotFound
+	}
+
+	viewName := ViewStandard
+	if _, ok, _ := c.UintArg(idx.ColumnLabel()); ok {
+		viewName = ViewInverse
+	}
+
+	// Parse time range.
+	startTimeStr, ok := c.Args["start"].(string)
+	if !ok {
+		return nil, errors.New("Range() start time required")
+	}
+	startTime, err := time.Parse(TimeFormat, startTimeStr)
+	if err != nil {
+		return nil, errors.New("cannot parse Range() start time")
+	}
+	endTimeStr, ok := c.Args["end"].(string)
+	if !ok {
+		return nil, errors.New("Range() end time required")
+	}
+	endTime, err := time.Parse(TimeFormat, endTimeStr)
+	if err != nil {
+		return nil, errors.New("cannot parse Range() end time")
+	}
+
+	// No views are read if the frame has no time quantum.
+	q := f.TimeQuantum()
+	if q == "" {
+		return []string{}, nil
+	}
+	return ViewsByTimeRange(viewName, startTime, endTime, q), nil
+}
+
+// missingFragments returns the fragments in views which do not exist locally.
+func (e *Executor) missingFragments(idx *Index, views []*ViewPlan, slices []uint64) []*FragmentPlan {
+	var a []*FragmentPlan
+	for _, vp := range views {
+		for _, view := range vp.Views {
+			for _, slice := range slices {
+				var frag *Fragment
+				if vp.Frame == ExistenceDir {
+					frag = idx.ExistenceView().Fragment(slice)
+				} else {
+					frag = e.Holder.Fragment(idx.Name(), vp.Frame, view, slice)
+				}
+
+				if frag == nil {
+					a = append(a, &FragmentPlan{Frame: vp.Frame, View: view, Slice: slice})
+				}
+			}
+		}
+	}
+	return a
+}
+
+// explainRemote returns the missing fragments for a call on a remote node.
+func (e *Executor) explainRemote(ctx context.Context, node *Node, index string, c *pql.Call, slices []uint64) ([]*FragmentPlan, error) {
+	uri, err := NewURIFromAddress(node.Host)
+	if err != nil {
+		return nil, err
+	}
+	uri.SetScheme(node.Scheme)
+
+	pb, err := e.client.ExecuteQuery(context.WithValue(ctx, "uri", uri), index, &internal.QueryRequest{
+		Query:   c.String(),
+		Slices:  slices,
+		Remote:  true,
+		Explain: true,
+	})
+	if err != nil {
+		return nil, err
+	} else if err := decodeError(pb.Err); err != nil {
+		return nil, err
+	} else if len(pb.Results) != 1 || pb.Results[0].Plan == nil {
+		return nil, fmt.Errorf("invalid explain response from %s", node.Host)
+	}
+
+	var a []*FragmentPlan
+	for _, nodePlan := range decodeCallPlan(pb.Results[0].Plan).Nodes {
+		a = append(a, nodePlan.MissingFragments...)
+	}
+	return a, nil
+}
+
+func encodeCallPlan(p *CallPlan) *internal.CallPlan {
+	pb := &internal.CallPlan{Call: p.Call}
+	for _, vp := range p.Views {
+		pb.Views = append(pb.Views, &internal.ViewPlan{
+			Call:  vp.Call,
+			Frame: vp.Frame,
+			Views: vp.Views,
+		})
+	}
+	for _, np := range p.Nodes {
+		pbNode := &internal.NodePlan{Host: np.Host, Slices: np.Slices}
+		for _, fp := range np.MissingFragments {
+			pbNode.MissingFragments = append(pbNode.MissingFragments, &internal.FragmentPlan{
+				Frame: fp.Frame,
+				View:  fp.View,
+				Slice: fp.Slice,
+			})
+		}
+		pb.Nodes = append(pb.Nodes, pbNode)
+	}
+	return pb
+}
+
+func decodeCallPlan(pb *internal.CallPlan) *CallPlan {
+	p := &CallPlan{Call: pb.Call}
+	for _, vp := range pb.Views {
+		p.Views = append(p.Views, &ViewPlan{
+			Call:  vp.Call,
+			Frame: vp.Frame,
+			Views: vp.Views,
+		})
+	}
+	for _, pbNode := range pb.Nodes {
+		np := &NodePlan{Host: pbNode.Host, Slices: pbNode.Slices}
+		for _, fp := range pbNode.MissingFragments {
+			np.MissingFragments = append(np.MissingFragments, &FragmentPlan{
+				Frame: fp.Frame,
+				View:  fp.View,
+				Slice: fp.Slice,
+			})
+		}
+		p.Nodes = append(p.Nodes, np)
+	}
+	return p
+}
@@ -18,6 +18,7 @@ import (
 	"archive/tar"
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"container/heap"
 	"context"
 	"crypto/sha1"
@@ -50,6 +51,10 @@ const (
 	// SnapshotExt is the file extension used for an in-process snapshot.
 	SnapshotExt = ".snapshotting"
 
+	// BackgroundSnapshotExt is the file extension used for a snapshot which
+	// is written by the holder's snapshot queue.
+	BackgroundSnapshotExt = ".bgsnapshotting"
+
 	// CopyExt is the file extension used for the temp file used while copying.
 	CopyExt = ".copying"
 
@@ -80,7 +85,12 @@ type Fragment struct {
 	file        *os.File
 	storage     *roaring.Bitmap
 	storageData []byte
-	opN         int // number of ops since snapshot
+	opN         int    // number of ops since snapshot
+	dirty       bool   // ops written since last fsync
+	storageGen  uint64 // incremented each time storage is closed
+
+	// Codec used to compress snapshots, if any.
+	Compression string // passed in by view
 
 	// Cache for row counts.
 	CacheType string // passed in by frame
@@ -101,6 +111,15 @@ type Fragment struct {
 	// so that they can be mmapped and heap utilization can be kept low.
 	MaxOpN int
 
+	// Durability policy for the ops log. The syncer is set by the holder and
+	// tracks fragments with unsynced ops.
+	FsyncPolicy string
+	syncer      *fragmentSyncer
+
+	// Queue used to snapshot in the background. If nil, snapshots are
+	// performed inline by the write which exceeds MaxOpN.
+	snapshotQueue *snapshotQueue
+
 	// Writer used for out-of-band log entries.
 	LogOutput io.Writer
 
@@ -122,8 +141,9 @@ func NewFragment(path, index, frame, view string, slice uint64) *Fragment {
 		CacheType: DefaultCacheType,
 		CacheSize: DefaultCacheSize,
 
-		LogOutput: ioutil.Discard,
-		MaxOpN:    DefaultFragmentMaxOpN,
+		LogOutput:   ioutil.Discard,
+		MaxOpN:      DefaultFragmentMaxOpN,
+		FsyncPolicy: FsyncNone,
 
 		stats: NopStatsClient,
 	}
@@ -208,7 +228,7 @@ func (f *Fragment) openStorage() error {
 		return err
 	} else if fi.Size() == 0 {
 		bi := bufio.NewWriter(f.file)
-		if _, err := f.storage.WriteTo(bi); err != nil {
+		if err := writeFragmentData(bi, f.storage, f.Compression); err != nil {
 			return fmt.Errorf("init storage file: %s", err)
 		}
 		bi.Flush()
@@ -219,7 +239,33 @@ func (f *Fragment) openStorage() error {
 	}
 
 	// Mmap the underlying file so it can be zero copied.
-	storageData, err := syscall.Mmap(int(f.file.Fd()), 0, int(fi.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err := f.mmapStorage(int(fi.Size())); err != nil {
+		return err
+	}
+
+	// Attach the mmap file to the bitmap. A torn op at the end of the log
+	// is left over from an interrupted write so it is trimmed off.
+	if err := f.unmarshalStorage(); err != nil {
+		e, ok := err.(*roaring.TornOpError)
+		if !ok {
+			return fmt.Errorf("unmarshal storage: file=%s, err=%s", f.file.Name(), err)
+		} else if err := f.truncateStorage(e.Offset); err != nil {
+			return fmt.Errorf("truncate storage: file=%s, err=%s", f.file.Name(), err)
+		}
+		f.logger().Printf("fragment: truncated torn op: offset=%d, err=%s, path=%s", e.Offset, e.Err, f.path)
+	}
+
+	// Attach the file to the bitmap to act as a write-ahead log.
+	f.storage.OpWriter = f.file
+	f.rowCache = &SimpleCache{make(map[uint64]*Bitmap)}
+
+	return nil
+
+}
+
+// mmapStorage maps the first size bytes of the data file into memory.
+func (f *Fragment) mmapStorage(size int) error {
+	storageData, err := syscall.Mmap(int(f.file.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
 	if err != nil {
 		return fmt.Errorf("mmap: %s", err)
 	}
@@ -229,19 +275,45 @@ func (f *Fragment) openStorage() error {
 	if err := madvise(f.storageData, syscall.MADV_RANDOM); err != nil {
 		return fmt.Errorf("madvise: %s", err)
 	}
+	return nil
+}
+
+// unmarshalStorage attaches the mapped data file to the storage bitmap.
+// Compressed files are decoded onto the heap first. The offset of a torn op
+// is reported relative to the data file.
+func (f *Fragment) unmarshalStorage() error {
+	data, _, err := DecodeFragmentData(f.storageData)
+	if err != nil {
+		return err
+	}
 
-	// Attach the mmap file to the bitmap.
-	data := f.storageData
 	if err := f.storage.UnmarshalBinary(data); err != nil {
-		return fmt.Errorf("unmarshal storage: file=%s, err=%s", f.file.Name(), err)
+		if e, ok := err.(*roaring.TornOpError); ok {
+			e.Offset += len(f.storageData) - len(data)
+		}
+		return err
 	}
+	return nil
+}
 
-	// Attach the file to the bitmap to act as a write-ahead log.
-	f.storage.OpWriter = f.file
-	f.rowCache = &SimpleCache{make(map[uint64]*Bitmap)}
+// truncateStorage trims the data file to size and reloads the storage.
+func (f *Fragment) truncateStorage(size int) error {
+	if err := syscall.Munmap(f.storageData); err != nil {
+		return fmt.Errorf("munmap: %s", err)
+	}
+	f.storageData = nil
 
-	return nil
+	if err := f.file.Truncate(int64(size)); err != nil {
+		return err
+	} else if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("sync: %s", err)
+	}
 
+	if err := f.mmapStorage(size); err != nil {
+		return err
+	}
+	f.storage = roaring.NewBitmap()
+	return f.unmarshalStorage()
 }
 
 // openCache initializes the cache from row ids persisted to disk.
@@ -329,13 +401,16 @@ func (f *Fragment) closeStorage() error {
 		if err := f.file.Sync(); err != nil {
 			return fmt.Errorf("sync: %s", err)
 		}
+		f.dirty = false
 		if err := syscall.Flock(int(f.file.Fd()), syscall.LOCK_UN); err != nil {
 			return fmt.Errorf("unlock: %s", err)
 		}
 		if err := f.file.Close(); err != nil {
 			return fmt.Errorf("close file: %s", err)
 		}
+		f.file = nil
 	}
+	f.storageGen++
 
 	return nil
 }
@@ -343,6 +418,27 @@ func (f *Fragment) closeStorage() error {
 // logger returns a logger instance for the fragment.nt.
 func (f *Fragment) logger() *log.Logger { return log.New(f.LogOutput, "", log.LstdFlags) }
 
+// Rows returns a sorted list of row IDs which have at least one bit set.
+func (f *Fragment) Rows() []uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rows()
+}
+
+func (f *Fragment) rows() []uint64 {
+	var a []uint64
+	itr := f.storage.Iterator()
+	itr.Seek(0)
+	for v, eof := itr.Next(); !eof; v, eof = itr.Next() {
+		rowID := v / SliceWidth
+		a = append(a, rowID)
+
+		// Skip to the beginning of the next row.
+		itr.Seek((rowID + 1) * SliceWidth)
+	}
+	return a
+}
+
 // Row returns a row by ID.
 func (f *Fragment) Row(rowID uint64) *Bitmap {
 	f.mu.Lock()
@@ -479,6 +575,68 @@ func (f *Fragment) clearBit(rowID, columnID uint64) (changed bool, err error) {
 	return changed, nil
 }
 
+// ClearRow clears all bits in a row.
+func (f *Fragment) ClearRow(rowID uint64) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.clearRow(rowID)
+}
+
+func (f *Fragment) clearRow(rowID uint64) (changed bool, err error) {
+	columnIDs := f.row(rowID, false, false).Bits()
+	if len(columnIDs) == 0 {
+		return false, nil
+	}
+
+	// Determine the position of each bit in the storage.
+	positions := make([]uint64, len(columnIDs))
+	for i, columnID := range columnIDs {
+		if positions[i], err = f.pos(rowID, columnID); err != nil {
+			return false, err
+		}
+	}
+
+	// Write to storage. Each removal is appended to the op log so the bits
+	// are not restored when the fragment is reopened.
+	if changed, err = f.storage.Remove(positions...); err != nil {
+		return false, err
+	}
+
+	// Invalidate block checksum.
+	delete(f.checksums, int(rowID/HashBlockSize))
+
+	// Replace the cached row and update the cache.
+	f.rowCache.Add(rowID, NewBitmap())
+	f.cache.Add(rowID, 0)
+
+	// Snapshot once enough operations have accumulated.
+	f.opN += len(positions) - 1
+	if err := f.incrementOpN(); err != nil {
+		return false, err
+	}
+
+	f.stats.Count("clearRow", 1, 1.0)
+
+	return changed, nil
+}
+
+// ClearColumn clears a column from every row in the fragment.
+func (f *Fragment) ClearColumn(columnID uint64) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var changed bool
+	for _, rowID := range f.rows() {
+		v, err := f.clearBit(rowID, columnID)
+		if err != nil {
+			return false, err
+		} else if v {
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
 func (f *Fragment) bit(rowID, columnID uint64) (bool, error) {
 	pos, err := f.pos(rowID, columnID)
 	if err != nil {
@@ -615,6 +773,64 @@ func (f *Fragment) FieldSum(filter *Bitmap, bitDepth uint) (sum, count uint64, e
 	return sum, count, nil
 }
 
+// FieldMin returns the minimum value of a given field as well as the number
+// of columns holding that value. A bitmap can be passed in to optionally
+// filter the computed columns.
+func (f *Fragment) FieldMin(filter *Bitmap, bitDepth uint) (min, count uint64, err error) {
+	// Only consider columns which have a value set.
+	consider := f.Row(uint64(bitDepth))
+	if filter != nil {
+		consider = consider.Intersect(filter)
+	}
+
+	// If there are no columns to consider, return early.
+	if consider.Count() == 0 {
+		return 0, 0, nil
+	}
+
+	// Walk from the most significant bit down. Keep the columns which do
+	// not have the bit set, if there are any, otherwise the bit must be
+	// part of the minimum value.
+	for i := bitDepth; i > uint(0); i-- {
+		row := f.Row(uint64(i - 1))
+		if x := consider.Difference(row); x.Count() > 0 {
+			consider = x
+		} else {
+			min += (1 << (i - 1))
+		}
+	}
+
+	return min, consider.Count(), nil
+}
+
+// FieldMax returns the maximum value of a given field as well as the number
+// of columns holding that value. A bitmap can be passed in to optionally
+// filter the computed columns.
+func (f *Fragment) FieldMax(filter *Bitmap, bitDepth uint) (max, count uint64, err error) {
+	// Only consider columns which have a value set.
+	consider := f.Row(uint64(bitDepth))
+	if filter != nil {
+		consider = consider.Intersect(filter)
+	}
+
+	// If there are no columns to consider, return early.
+	if consider.Count() == 0 {
+		return 0, 0, nil
+	}
+
+	// Walk from the most significant bit down. Keep the columns which have
+	// the bit set, if there are any, since they make up the maximum value.
+	for i := bitDepth; i > uint(0); i-- {
+		row := f.Row(uint64(i - 1))
+		if x := consider.Intersect(row); x.Count() > 0 {
+			consider = x
+			max += (1 << (i - 1))
+		}
+	}
+
+	return max, consider.Count(), nil
+}
+
 func (f *Fragment) FieldRange(op pql.Token, bitDepth uint, predicate uint64) (*Bitmap, error) {
 	switch op {
 	case pql.EQ:
@@ -792,6 +1008,39 @@ func (f *Fragment) FieldRangeBetween(bitDepth uint, predicateMin, predicateMax u
 	return b, nil
 }
 
+// FieldRerangeRows returns the bit planes of a field after adding delta to
+// every value and re-encoding it with newBitDepth bits. The returned slice
+// holds newBitDepth+1 rows with the not-null row last.
+//
+// The delta is added modulo 2^newBitDepth so a negative delta can be passed
+// in two's complement as long as every resulting value fits in newBitDepth.
+func (f *Fragment) FieldRerangeRows(bitDepth, newBitDepth uint, delta uint64) []*Bitmap {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Add the constant to every column using a bit-sliced ripple-carry adder.
+	notNull := f.row(uint64(bitDepth), true, false)
+	carry := NewBitmap()
+	rows := make([]*Bitmap, newBitDepth+1)
+	for i := uint(0); i < newBitDepth; i++ {
+		row := NewBitmap()
+		if i < bitDepth {
+			row = f.row(uint64(i), true, false)
+		}
+
+		if (delta>>i)&1 == 1 {
+			rows[i] = notNull.Difference(row.Xor(carry))
+			carry = row.Union(carry)
+		} else {
+			rows[i] = row.Xor(carry)
+			carry = row.Intersect(carry)
+		}
+	}
+	rows[newBitDepth] = notNull
+
+	return rows
+}
+
 // pos translates the row ID and column ID into a position in the storage bitmap.
 func (f *Fragment) pos(rowID, columnID uint64) (uint64, error) {
 	// Return an error if the column ID is out of the range of the fragment's slice.
@@ -824,6 +1073,7 @@ func (f *Fragment) ForEachBit(fn func(rowID, columnID uint64) error) error {
 // Top returns the top rows from the fragment.
 // If opt.Src is specified then only rows which intersect src are returned.
 // If opt.FilterValues exist then the row attribute specified by field is matched.
+// If opt.AttrFilter is specified then only rows whose attributes match are returned.
 func (f *Fragment) Top(opt TopOptions) ([]Pair, error) {
 	// Retrieve pairs. If no row ids specified then return from cache.
 	pairs := f.topBitmapPairs(opt.RowIDs)
@@ -876,16 +1126,23 @@ func (f *Fragment) Top(opt TopOptions) ([]Pair, error) {
 			}
 		}
 
-		// Apply filter, if set.
-		if filters != nil {
+		// Apply filters, if set.
+		if filters != nil || opt.AttrFilter != nil {
 			attr, err := f.RowAttrStore.Attrs(rowID)
 			if err != nil {
 				return nil, err
-			} else if attr == nil {
-				continue
-			} else if attrValue := attr[opt.FilterField]; attrValue == nil {
-				continue
-			} else if _, ok := filters[attrValue]; !ok {
+			}
+
+			if filters != nil {
+				if attr == nil {
+					continue
+				} else if attrValue := attr[opt.FilterField]; attrValue == nil {
+					continue
+				} else if _, ok := filters[attrValue]; !ok {
+					continue
+				}
+			}
+			if opt.AttrFilter != nil && !opt.AttrFilter.Match(attr) {
 				continue
 			}
 		}
@@ -1012,6 +1269,9 @@ type TopOptions struct {
 	FilterField       string
 	FilterValues      []interface{}
 	TanimotoThreshold uint64
+
+	// Boolean expression over row attributes.
+	AttrFilter AttrFilter
 }
 
 // Checksum returns a checksum for the entire fragment.
@@ -1361,13 +1621,20 @@ func (f *Fragment) ImportValue(columnIDs, values []uint64, bitDepth uint) error
 }
 
 // incrementOpN increase the operation count by one.
-// If the count exceeds the maximum allowed then a snapshot is performed.
+// If the count exceeds the maximum allowed then a snapshot is performed, or
+// queued if the fragment belongs to a holder.
 func (f *Fragment) incrementOpN() error {
 	f.opN++
+	f.markDirty()
 	if f.opN <= f.MaxOpN {
 		return nil
 	}
 
+	if f.snapshotQueue != nil {
+		f.snapshotQueue.enqueue(f, f.opN)
+		return nil
+	}
+
 	if err := f.snapshot(); err != nil {
 		return fmt.Errorf("snapshot: %s", err)
 	}
@@ -1403,7 +1670,7 @@ func (f *Fragment) snapshot() error {
 
 	// Write storage to snapshot.
 	bw := bufio.NewWriter(file)
-	if _, err := f.storage.WriteTo(bw); err != nil {
+	if err := writeFragmentData(bw, f.storage, f.Compression); err != nil {
 		return fmt.Errorf("snapshot write to: %s", err)
 	}
 
@@ -1411,6 +1678,13 @@ func (f *Fragment) snapshot() error {
 		return fmt.Errorf("flush: %s", err)
 	}
 
+	// Make sure the snapshot is on disk before it replaces the data file.
+	if f.FsyncPolicy != FsyncNone {
+		if err := file.Sync(); err != nil {
+			return fmt.Errorf("sync: %s", err)
+		}
+	}
+
 	// Close current storage.
 	if err := f.closeStorage(); err != nil {
 		return fmt.Errorf("close storage: %s", err)
@@ -1432,6 +1706,136 @@ func (f *Fragment) snapshot() error {
 	return nil
 }
 
+// backgroundSnapshot writes a snapshot without holding the fragment lock
+// while the storage is serialized. Only the end of the log is noted under the
+// lock; the snapshot is rebuilt from the data file up to that offset. Ops
+// appended to the log in the meantime are copied to the end of the snapshot
+// before it replaces the data file.
+func (f *Fragment) backgroundSnapshot() error {
+	// Note where the log ends and open a separate handle to the data file.
+	// The file is append-only and snapshots replace it by rename, so data
+	// before the offset stays the same while the lock is released.
+	f.mu.Lock()
+	if f.file == nil || f.opN <= f.MaxOpN {
+		f.mu.Unlock()
+		return nil
+	}
+	fi, err := f.file.Stat()
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	offset, opN, gen := fi.Size(), f.opN, f.storageGen
+	src, err := os.Open(f.path)
+	f.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("open data file: %s", err)
+	}
+	defer src.Close()
+
+	logger := f.logger()
+	logger.Printf("fragment: snapshotting in background %s/%s/%s/%d", f.index, f.frame, f.view, f.slice)
+	completeMessage := fmt.Sprintf("fragment: background snapshot complete %s/%s/%s/%d", f.index, f.frame, f.view, f.slice)
+	start := time.Now()
+	defer track(start, completeMessage, f.stats, logger)
+
+	// Rebuild the storage from the data file up to the offset.
+	buf, err := ioutil.ReadAll(io.NewSectionReader(src, 0, offset))
+	if err != nil {
+		return fmt.Errorf("read data file: %s", err)
+	}
+	data, _, err := DecodeFragmentData(buf)
+	if err != nil {
+		return err
+	}
+	bm := roaring.NewBitmap()
+	if err := bm.UnmarshalBinary(data); err != nil {
+		return fmt.Errorf("unmarshal storage: %s", err)
+	}
+
+	// Write the rebuilt storage to a temporary file.
+	snapshotPath := f.path + BackgroundSnapshotExt
+	file, err := os.Create(snapshotPath)
+	if err != nil {
+		return fmt.Errorf("create snapshot file: %s", err)
+	}
+	defer file.Close()
+
+	bw := bufio.NewWriter(file)
+	if err := writeFragmentData(bw, bm, f.Compression); err != nil {
+		return fmt.Errorf("snapshot write to: %s", err)
+	} else if err := bw.Flush(); err != nil {
+		return fmt.Errorf("flush: %s", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Discard the snapshot if the storage was replaced while writing.
+	if f.storageGen != gen {
+		if err := os.Remove(snapshotPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	// Append ops written since the offset was noted.
+	fi, err = f.file.Stat()
+	if err != nil {
+		return err
+	} else if _, err := io.Copy(file, io.NewSectionReader(f.file, offset, fi.Size()-offset)); err != nil {
+		return fmt.Errorf("copy ops: %s", err)
+	}
+
+	// Make sure the snapshot is on disk before it replaces the data file.
+	if f.FsyncPolicy != FsyncNone {
+		if err := file.Sync(); err != nil {
+			return fmt.Errorf("sync: %s", err)
+		}
+	}
+
+	// Swap the snapshot in for the current data file.
+	if err := f.closeStorage(); err != nil {
+		return fmt.Errorf("close storage: %s", err)
+	} else if err := os.Rename(snapshotPath, f.path); err != nil {
+		return fmt.Errorf("rename snapshot: %s", err)
+	} else if err := f.openStorage(); err != nil {
+		return fmt.Errorf("open storage: %s", err)
+	}
+
+	// Only ops appended after the offset remain in the log.
+	f.opN -= opN
+
+	return nil
+}
+
+// markDirty flags the fragment as having unsynced ops and registers it with
+// the syncer, if the fsync policy requires it.
+func (f *Fragment) markDirty() {
+	if f.dirty || f.FsyncPolicy == FsyncNone {
+		return
+	}
+	f.dirty = true
+
+	if f.syncer != nil {
+		f.syncer.add(f)
+	}
+}
+
+// Sync flushes ops written since the last sync to disk.
+func (f *Fragment) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.dirty || f.file == nil {
+		return nil
+	} else if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("sync: %s", err)
+	}
+	f.dirty = false
+	return nil
+}
+
 // RecalculateCache rebuilds the cache regardless of invalidate time delay.
 func (f *Fragment) RecalculateCache() {
 	f.mu.Lock()
@@ -1606,6 +2010,11 @@ func (f *Fragment) readStorageFromArchive(r io.Reader) error {
 		return err
 	}
 
+	// Verify the data can be read before replacing the current storage.
+	if err := checkFragmentFile(file); err != nil {
+		return fmt.Errorf("invalid fragment data: %s", err)
+	}
+
 	// Close current storage.
 	if err := f.closeStorage(); err != nil {
 		return err
@@ -1889,6 +2298,129 @@ func byteSlicesEqual(a [][]byte) bool {
 	return true
 }
 
+// compressedFragmentMagic identifies a data file with a compressed snapshot.
+// It can't be mistaken for the magic number of an uncompressed roaring file.
+var compressedFragmentMagic = []byte("PLZF")
+
+const (
+	// compressedFragmentVersion is the current version of the header.
+	compressedFragmentVersion = 1
+
+	// compressedFragmentHeaderSize is the size of the magic, the version,
+	// the codec and the size of the compressed data.
+	compressedFragmentHeaderSize = 4 + 1 + 1 + 8
+)
+
+// Codec identifiers stored in the compressed fragment header.
+var compressionCodecs = map[string]byte{
+	CompressionGzip: 1,
+}
+
+// writeFragmentData writes a snapshot of bm to w, compressed with the given
+// codec. Ops are appended after the snapshot in either format.
+func writeFragmentData(w io.Writer, bm *roaring.Bitmap, compression string) error {
+	if compression == CompressionNone {
+		_, err := bm.WriteTo(w)
+		return err
+	}
+
+	codec, ok := compressionCodecs[compression]
+	if !ok {
+		return ErrInvalidCompression
+	}
+
+	// Compress to a buffer so the size can be written in the header.
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := bm.WriteTo(zw); err != nil {
+		return err
+	} else if err := zw.Close(); err != nil {
+		return err
+	}
+
+	hdr := make([]byte, compressedFragmentHeaderSize)
+	copy(hdr, compressedFragmentMagic)
+	hdr[4] = compressedFragmentVersion
+	hdr[5] = codec
+	binary.LittleEndian.PutUint64(hdr[6:], uint64(buf.Len()))
+
+	if _, err := w.Write(hdr); err != nil {
+		return err
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// DecodeFragmentData returns the roaring data, followed by the ops log, for
+// the contents of a fragment data file along with the compression codec used.
+// Uncompressed data is returned as is.
+func DecodeFragmentData(data []byte) ([]byte, string, error) {
+	if !bytes.HasPrefix(data, compressedFragmentMagic) {
+		return data, CompressionNone, nil
+	} else if len(data) < compressedFragmentHeaderSize {
+		return nil, "", errors.New("compressed fragment header too small")
+	} else if v := data[4]; v != compressedFragmentVersion {
+		return nil, "", fmt.Errorf("unsupported compressed fragment version: %d", v)
+	}
+
+	var compression string
+	for name, codec := range compressionCodecs {
+		if codec == data[5] {
+			compression = name
+		}
+	}
+	if compression == "" {
+		return nil, "", fmt.Errorf("unknown compression codec: %d", data[5])
+	}
+
+	sz := binary.LittleEndian.Uint64(data[6:compressedFragmentHeaderSize])
+	if sz > uint64(len(data)-compressedFragmentHeaderSize) {
+		return nil, "", fmt.Errorf("compressed data out of bounds: size=%d, len=%d", sz, len(data))
+	}
+	end := compressedFragmentHeaderSize + int(sz)
+
+	zr, err := gzip.NewReader(bytes.NewReader(data[compressedFragmentHeaderSize:end]))
+	if err != nil {
+		return nil, "", err
+	}
+	buf, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The ops log follows the compressed data.
+	return append(buf, data[end:]...), compression, nil
+}
+
+// checkFragmentFile verifies that the data file can be decoded.
+func checkFragmentFile(file *os.File) error {
+	fi, err := file.Stat()
+	if err != nil {
+		return err
+	} else if fi.Size() == 0 {
+		return nil
+	}
+
+	data, err := syscall.Mmap(int(file.Fd()), 0, int(fi.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return fmt.Errorf("mmap: %s", err)
+	}
+	defer syscall.Munmap(data)
+
+	buf, _, err := DecodeFragmentData(data)
+	if err != nil {
+		return err
+	}
+
+	// A torn op is trimmed when the storage is opened.
+	if err := roaring.NewBitmap().UnmarshalBinary(buf); err != nil {
+		if _, ok := err.(*roaring.TornOpError); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
 // Pos returns the row position of a row/column pair.
 func Pos(rowID, columnID uint64) uint64 {
 	return (rowID * SliceWidth) + (columnID % SliceWidth)
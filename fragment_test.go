@@ -17,7 +17,9 @@ package pilosa_test
 import (
 	"bytes"
 	"flag"
+	"io/ioutil"
 	"math"
+	"os"
 	"reflect"
 	"testing"
 	"testing/quick"
@@ -67,6 +69,23 @@ func TestFragment_SetBit(t *testing.T) {
 	}
 }
 
+// Ensure a fragment can list the rows which contain bits.
+func TestFragment_Rows(t *testing.T) {
+	f := test.MustOpenFragment("i", "f", pilosa.ViewStandard, 0, "")
+	defer f.Close()
+
+	if rows := f.Rows(); len(rows) != 0 {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+
+	f.MustSetBits(0, 1, 100)
+	f.MustSetBits(3, SliceWidth-1)
+	f.MustSetBits(1000, 5)
+	if rows := f.Rows(); !reflect.DeepEqual(rows, []uint64{0, 3, 1000}) {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+}
+
 // Ensure a fragment can clear a set bit.
 func TestFragment_ClearBit(t *testing.T) {
 	f := test.MustOpenFragment("i", "f", pilosa.ViewStandard, 0, "")
@@ -94,6 +113,68 @@ func TestFragment_ClearBit(t *testing.T) {
 	}
 }
 
+// Ensure a fragment can clear an entire row.
+func TestFragment_ClearRow(t *testing.T) {
+	f := test.MustOpenFragment("i", "f", pilosa.ViewStandard, 0, pilosa.CacheTypeRanked)
+	defer f.Close()
+
+	f.MustSetBits(1000, 1, 2, 3)
+	f.MustSetBits(1001, 2)
+	if n := f.Row(1000).Count(); n != 3 {
+		t.Fatalf("unexpected count: %d", n)
+	}
+
+	if changed, err := f.ClearRow(1000); err != nil {
+		t.Fatal(err)
+	} else if !changed {
+		t.Fatal("expected change")
+	} else if changed, err := f.ClearRow(1000); err != nil {
+		t.Fatal(err)
+	} else if changed {
+		t.Fatal("expected no change")
+	}
+
+	// Verify row, neighboring row & cache.
+	if n := f.Row(1000).Count(); n != 0 {
+		t.Fatalf("unexpected count: %d", n)
+	} else if n := f.Row(1001).Count(); n != 1 {
+		t.Fatalf("unexpected neighbor count: %d", n)
+	} else if n := f.Cache().Get(1000); n != 0 {
+		t.Fatalf("unexpected cache count: %d", n)
+	}
+
+	// Close and reopen the fragment & verify the data.
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if n := f.Row(1000).Count(); n != 0 {
+		t.Fatalf("unexpected count (reopen): %d", n)
+	}
+}
+
+// Ensure a fragment can clear a column from every row.
+func TestFragment_ClearColumn(t *testing.T) {
+	f := test.MustOpenFragment("i", "f", pilosa.ViewInverse, 0, "")
+	defer f.Close()
+
+	f.MustSetBits(1, 5, 6)
+	f.MustSetBits(2, 5)
+	f.MustSetBits(3, 6)
+
+	if changed, err := f.ClearColumn(5); err != nil {
+		t.Fatal(err)
+	} else if !changed {
+		t.Fatal("expected change")
+	}
+
+	if bits := f.Row(1).Bits(); !reflect.DeepEqual(bits, []uint64{6}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	} else if bits := f.Row(2).Bits(); len(bits) != 0 {
+		t.Fatalf("unexpected bits: %v", bits)
+	} else if bits := f.Row(3).Bits(); !reflect.DeepEqual(bits, []uint64{6}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	}
+}
+
 // Ensure a fragment can set & read a field value.
 func TestFragment_SetFieldValue(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
@@ -256,6 +337,69 @@ func TestFragment_FieldSum(t *testing.T) {
 	})
 }
 
+// Ensure a fragment can find the min and max field values.
+func TestFragment_FieldMinMax(t *testing.T) {
+	const bitDepth = 16
+
+	f := test.MustOpenFragment("i", "f", pilosa.ViewStandard, 0, "")
+	defer f.Close()
+
+	// Set values.
+	if _, err := f.SetFieldValue(1000, bitDepth, 382); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetFieldValue(2000, bitDepth, 300); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetFieldValue(3000, bitDepth, 2818); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetFieldValue(4000, bitDepth, 300); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Min", func(t *testing.T) {
+		tests := []struct {
+			filter *pilosa.Bitmap
+			exp    uint64
+			cnt    uint64
+		}{
+			{filter: nil, exp: 300, cnt: 2},
+			{filter: pilosa.NewBitmap(1000, 3000), exp: 382, cnt: 1},
+			{filter: pilosa.NewBitmap(3000), exp: 2818, cnt: 1},
+			{filter: pilosa.NewBitmap(5000), exp: 0, cnt: 0},
+		}
+		for i, test := range tests {
+			if min, cnt, err := f.FieldMin(test.filter, bitDepth); err != nil {
+				t.Fatal(err)
+			} else if min != test.exp {
+				t.Fatalf("%d. unexpected min: got=%d, exp=%d", i, min, test.exp)
+			} else if cnt != test.cnt {
+				t.Fatalf("%d. unexpected count: got=%d, exp=%d", i, cnt, test.cnt)
+			}
+		}
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		tests := []struct {
+			filter *pilosa.Bitmap
+			exp    uint64
+			cnt    uint64
+		}{
+			{filter: nil, exp: 2818, cnt: 1},
+			{filter: pilosa.NewBitmap(1000, 2000, 4000), exp: 382, cnt: 1},
+			{filter: pilosa.NewBitmap(2000, 4000), exp: 300, cnt: 2},
+			{filter: pilosa.NewBitmap(5000), exp: 0, cnt: 0},
+		}
+		for i, test := range tests {
+			if max, cnt, err := f.FieldMax(test.filter, bitDepth); err != nil {
+				t.Fatal(err)
+			} else if max != test.exp {
+				t.Fatalf("%d. unexpected max: got=%d, exp=%d", i, max, test.exp)
+			} else if cnt != test.cnt {
+				t.Fatalf("%d. unexpected count: got=%d, exp=%d", i, cnt, test.cnt)
+			}
+		}
+	})
+}
+
 // Ensure a fragment query for matching fields.
 func TestFragment_FieldRange(t *testing.T) {
 	const bitDepth = 16
@@ -480,6 +624,107 @@ func TestFragment_Snapshot(t *testing.T) {
 	}
 }
 
+// Ensure a partially written op at the end of the log is trimmed on open.
+func TestFragment_TornOp(t *testing.T) {
+	f := test.MustOpenFragment("i", "f", pilosa.ViewStandard, 0, "")
+	defer f.Close()
+
+	if _, err := f.SetBit(1000, 1); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetBit(1000, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	fi, err := os.Stat(f.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Append the start of an op as if a write was interrupted.
+	if file, err := os.OpenFile(f.Path(), os.O_WRONLY|os.O_APPEND, 0666); err != nil {
+		t.Fatal(err)
+	} else if _, err := file.Write([]byte{0, 1, 2, 3}); err != nil {
+		t.Fatal(err)
+	} else if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen and verify the torn op was removed and prior ops kept.
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if a := f.Row(1000).Bits(); !reflect.DeepEqual(a, []uint64{1, 2}) {
+		t.Fatalf("unexpected bits: %+v", a)
+	} else if fi2, err := os.Stat(f.Path()); err != nil {
+		t.Fatal(err)
+	} else if fi2.Size() != fi.Size() {
+		t.Fatalf("unexpected file size: %d != %d", fi2.Size(), fi.Size())
+	}
+
+	// Verify new ops are appended after the trimmed log.
+	if _, err := f.SetBit(1000, 3); err != nil {
+		t.Fatal(err)
+	} else if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if a := f.Row(1000).Bits(); !reflect.DeepEqual(a, []uint64{1, 2, 3}) {
+		t.Fatalf("unexpected bits: %+v", a)
+	}
+}
+
+// Ensure a fragment can write compressed snapshots and read them back.
+func TestFragment_Compression(t *testing.T) {
+	f := test.NewFragment("i", "f", pilosa.ViewStandard, 0, pilosa.DefaultCacheType)
+	f.Compression = pilosa.CompressionGzip
+	if err := f.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	f.MustSetBits(100, 1, 2, 3)
+	if err := f.Snapshot(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Append ops after the compressed snapshot, the last of which is torn.
+	f.MustSetBits(100, 4)
+	f.MustClearBits(100, 1)
+	if file, err := os.OpenFile(f.Path(), os.O_WRONLY|os.O_APPEND, 0666); err != nil {
+		t.Fatal(err)
+	} else if _, err := file.Write([]byte{0, 1, 2}); err != nil {
+		t.Fatal(err)
+	} else if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify the file is compressed.
+	if buf, err := ioutil.ReadFile(f.Path()); err != nil {
+		t.Fatal(err)
+	} else if _, compression, err := pilosa.DecodeFragmentData(buf); err != nil {
+		t.Fatal(err)
+	} else if compression != pilosa.CompressionGzip {
+		t.Fatalf("unexpected compression: %q", compression)
+	}
+
+	// Reopen and verify the snapshot and the ops are read.
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if a := f.Row(100).Bits(); !reflect.DeepEqual(a, []uint64{2, 3, 4}) {
+		t.Fatalf("unexpected bits: %+v", a)
+	}
+
+	// Verify the data can be backed up & restored into an uncompressed fragment.
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	f2 := test.MustOpenFragment("i", "f", pilosa.ViewStandard, 0, "")
+	defer f2.Close()
+	if _, err := f2.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	} else if a := f2.Row(100).Bits(); !reflect.DeepEqual(a, []uint64{2, 3, 4}) {
+		t.Fatalf("unexpected restored bits: %+v", a)
+	}
+}
+
 // Ensure a fragment can iterate over all bits in order.
 func TestFragment_ForEachBit(t *testing.T) {
 	f := test.MustOpenFragment("i", "f", pilosa.ViewStandard, 0, "")
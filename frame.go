@@ -15,6 +15,8 @@
 package pilosa
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -22,6 +24,8 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -44,29 +48,50 @@ const (
 // Frame represents a container for views.
 type Frame struct {
 	mu          sync.RWMutex
+	fieldMu     sync.RWMutex // blocks field value writes while re-ranging
+	fieldViewMu sync.RWMutex // blocks replacing field views while they are read
 	path        string
 	index       string
 	name        string
 	timeQuantum TimeQuantum
 	schema      *FrameSchema
 
+	// Incremented on every time quantum or field change so that nodes can
+	// reconcile their schema with the most recent version.
+	schemaVersion uint64
+
 	views map[string]*View
 
 	// Row attribute storage and cache
 	rowAttrStore *AttrStore
 
+	// Row key translation storage.
+	translateStore *TranslateStore
+
+	// Column existence view shared by all frames in the index.
+	existence *View
+
 	broadcaster Broadcaster
 	Stats       StatsClient
 
+	syncer        *fragmentSyncer
+	snapshotQueue *snapshotQueue
+
 	// Frame settings.
 	rowLabel       string
 	cacheType      string
 	inverseEnabled bool
 	rangeEnabled   bool
+	keys           bool
 
 	// Cache size for ranked frames
 	cacheSize uint32
 
+	// Compression codec for fragment snapshots and, optionally, the prefixes
+	// of the views it is limited to.
+	compression     string
+	compressedViews []string
+
 	LogOutput io.Writer
 }
 
@@ -86,6 +111,8 @@ func NewFrame(path, index, name string) (*Frame, error) {
 		views:        make(map[string]*View),
 		rowAttrStore: NewAttrStore(filepath.Join(path, ".data")),
 
+		translateStore: NewTranslateStore(filepath.Join(path, TranslateFile)),
+
 		broadcaster: NopBroadcaster,
 		Stats:       NopStatsClient,
 
@@ -111,6 +138,16 @@ func (f *Frame) Path() string { return f.path }
 // RowAttrStore returns the attribute storage.
 func (f *Frame) RowAttrStore() *AttrStore { return f.rowAttrStore }
 
+// TranslateStore returns the storage for row key translation.
+func (f *Frame) TranslateStore() *TranslateStore { return f.translateStore }
+
+// Keys returns true if the frame translates string keys to row IDs.
+func (f *Frame) Keys() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.keys
+}
+
 // MaxSlice returns the max slice in the frame.
 func (f *Frame) MaxSlice() uint64 {
 	f.mu.RLock()
@@ -224,13 +261,16 @@ func (f *Frame) Options() FrameOptions {
 
 func (f *Frame) options() FrameOptions {
 	return FrameOptions{
-		RowLabel:       f.rowLabel,
-		InverseEnabled: f.inverseEnabled,
-		RangeEnabled:   f.rangeEnabled,
-		CacheType:      f.cacheType,
-		CacheSize:      f.cacheSize,
-		TimeQuantum:    f.timeQuantum,
-		Fields:         f.schema.Fields,
+		RowLabel:        f.rowLabel,
+		InverseEnabled:  f.inverseEnabled,
+		RangeEnabled:    f.rangeEnabled,
+		CacheType:       f.cacheType,
+		CacheSize:       f.cacheSize,
+		TimeQuantum:     f.timeQuantum,
+		Fields:          f.schema.Fields,
+		Keys:            f.keys,
+		Compression:     f.compression,
+		CompressedViews: f.compressedViews,
 	}
 }
 
@@ -246,6 +286,8 @@ func (f *Frame) Open() error {
 			return err
 		} else if err := f.loadSchema(); err != nil {
 			return err
+		} else if err := f.recoverReranges(); err != nil {
+			return err
 		}
 
 		if err := f.openViews(); err != nil {
@@ -256,6 +298,12 @@ func (f *Frame) Open() error {
 			return err
 		}
 
+		if f.keys {
+			if err := f.translateStore.Open(); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}(); err != nil {
 		f.Close()
@@ -310,6 +358,10 @@ func (f *Frame) loadMeta() error {
 		f.inverseEnabled = DefaultInverseEnabled
 		f.rangeEnabled = DefaultRangeEnabled
 		f.cacheSize = DefaultCacheSize
+		f.keys = false
+		f.compression = CompressionNone
+		f.compressedViews = nil
+		f.schemaVersion = 0
 		return nil
 	} else if err != nil {
 		return err
@@ -325,6 +377,10 @@ func (f *Frame) loadMeta() error {
 	f.inverseEnabled = pb.InverseEnabled
 	f.rangeEnabled = pb.RangeEnabled
 	f.cacheSize = pb.CacheSize
+	f.keys = pb.Keys
+	f.compression = pb.Compression
+	f.compressedViews = pb.CompressedViews
+	f.schemaVersion = pb.SchemaVersion
 
 	// Copy cache type.
 	f.cacheType = pb.CacheType
@@ -339,7 +395,9 @@ func (f *Frame) loadMeta() error {
 func (f *Frame) saveMeta() error {
 	// Marshal metadata.
 	fo := f.options()
-	buf, err := proto.Marshal(fo.Encode())
+	pb := fo.Encode()
+	pb.SchemaVersion = f.schemaVersion
+	buf, err := proto.Marshal(pb)
 	if err != nil {
 		return err
 	}
@@ -371,11 +429,14 @@ func (f *Frame) loadSchema() error {
 	return nil
 }
 
-// saveSchema writes the current schema to disk.
+// saveSchema atomically writes the current schema to disk.
 func (f *Frame) saveSchema() error {
+	path := filepath.Join(f.path, ".schema")
 	if buf, err := proto.Marshal(encodeFrameSchema(f.schema)); err != nil {
 		return err
-	} else if err := ioutil.WriteFile(filepath.Join(f.path, ".schema"), buf, 0666); err != nil {
+	} else if err := ioutil.WriteFile(path+".tmp", buf, 0666); err != nil {
+		return err
+	} else if err := os.Rename(path+".tmp", path); err != nil {
 		return err
 	}
 	return nil
@@ -391,6 +452,11 @@ func (f *Frame) Close() error {
 		_ = f.rowAttrStore.Close()
 	}
 
+	// Close the key translation store.
+	if f.translateStore != nil {
+		_ = f.translateStore.Close()
+	}
+
 	// Close all views.
 	for _, view := range f.views {
 		if err := view.Close(); err != nil {
@@ -419,6 +485,27 @@ func (f *Frame) Field(name string) *Field {
 	return nil
 }
 
+// fieldView returns a field and its view, which is nil if the field has no
+// data. The view is not closed or replaced by a re-range until release is
+// called, so the field's encoding matches the view's data until then.
+func (f *Frame) fieldView(name string) (field *Field, view *View, release func()) {
+	f.fieldViewMu.RLock()
+	if field = f.Field(name); field != nil {
+		view = f.View(ViewFieldPrefix + name)
+	}
+	return field, view, f.fieldViewMu.RUnlock
+}
+
+// fieldFragment returns a field and its fragment for a slice. The fragment is
+// nil if the slice has no data for the field. See fieldView for release.
+func (f *Frame) fieldFragment(name string, slice uint64) (field *Field, frag *Fragment, release func()) {
+	field, view, release := f.fieldView(name)
+	if view != nil {
+		frag = view.Fragment(slice)
+	}
+	return field, frag, release
+}
+
 // CreateField creates a new field on the schema.
 func (f *Frame) CreateField(field *Field) error {
 	f.mu.Lock()
@@ -436,7 +523,9 @@ func (f *Frame) CreateField(field *Field) error {
 	}
 	f.schema = schema
 	f.saveSchema()
-	return nil
+
+	f.schemaVersion = nextSchemaVersion(f.schemaVersion)
+	return f.saveMeta()
 }
 
 // GetFields returns a list of all the fields in the frame.
@@ -459,6 +548,12 @@ func (f *Frame) GetFields() (*FrameSchema, error) {
 
 // DeleteField deletes an existing field on the schema.
 func (f *Frame) DeleteField(name string) error {
+	f.fieldMu.Lock()
+	defer f.fieldMu.Unlock()
+
+	f.fieldViewMu.Lock()
+	defer f.fieldViewMu.Unlock()
+
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
@@ -472,7 +567,20 @@ func (f *Frame) DeleteField(name string) error {
 	if err := schema.DeleteField(name); err != nil {
 		return err
 	}
+	schema.deleteRerange(name)
 	f.schema = schema
+	if err := f.saveSchema(); err != nil {
+		return err
+	}
+	f.schemaVersion = nextSchemaVersion(f.schemaVersion)
+	if err := f.saveMeta(); err != nil {
+		return err
+	}
+
+	// Remove any partially re-ranged data.
+	if err := os.RemoveAll(f.rerangePath(name)); err != nil {
+		return err
+	}
 
 	// Remove views.
 	viewName := ViewFieldPrefix + name
@@ -489,6 +597,242 @@ func (f *Frame) DeleteField(name string) error {
 	return nil
 }
 
+// RerangeField changes the min & max of an existing field. The data in the
+// field's view is re-encoded for the new range one fragment at a time into a
+// staging view while queries continue to read the current view. Progress is
+// recorded in the schema so that an interrupted re-range can be resumed by
+// calling RerangeField again with the same range.
+//
+// Writes to fields on the frame are blocked until the re-range completes.
+// Returns ErrFieldValueTooLow or ErrFieldValueTooHigh if existing values do
+// not fit in the new range.
+func (f *Frame) RerangeField(name string, min, max int64) error {
+	f.fieldMu.Lock()
+	defer f.fieldMu.Unlock()
+
+	// Ensure frame supports fields.
+	if !f.RangeEnabled() {
+		return ErrFrameFieldsNotAllowed
+	}
+
+	// Build the new field definition.
+	field := f.Field(name)
+	if field == nil {
+		return ErrFieldNotFound
+	}
+	other := &Field{Name: field.Name, Type: field.Type, Min: min, Max: max, Scale: field.Scale, Unit: field.Unit}
+	if err := ValidateField(other); err != nil {
+		return err
+	} else if other.Min == field.Min && other.Max == field.Max {
+		return nil
+	}
+
+	// Fields without data only require a schema change.
+	view := f.View(ViewFieldPrefix + name)
+	if view == nil {
+		if err := os.RemoveAll(f.rerangePath(name)); err != nil {
+			return err
+		}
+		return f.commitRerange(&FieldRerange{Field: other})
+	}
+
+	// Sort fragments so that progress is made in slice order.
+	frags := view.Fragments()
+	sort.Slice(frags, func(i, j int) bool { return frags[i].Slice() < frags[j].Slice() })
+
+	// Ensure existing values fit in the new range.
+	for _, frag := range frags {
+		if vmin, count, err := frag.FieldMin(nil, field.BitDepth()); err != nil {
+			return err
+		} else if count > 0 && int64(vmin)+field.Min < other.Min {
+			return ErrFieldValueTooLow
+		}
+		if vmax, count, err := frag.FieldMax(nil, field.BitDepth()); err != nil {
+			return err
+		} else if count > 0 && int64(vmax)+field.Min > other.Max {
+			return ErrFieldValueTooHigh
+		}
+	}
+
+	// Resume a previous re-range to the same range or start over.
+	rerange := f.Schema().Rerange(name)
+	if rerange == nil || *rerange.Field != *other {
+		if err := os.RemoveAll(f.rerangePath(name)); err != nil {
+			return err
+		}
+		rerange = &FieldRerange{Field: other}
+		if err := f.saveRerange(rerange); err != nil {
+			return err
+		}
+	}
+
+	// Open staging view. Fragments which were not completed are discarded.
+	staging := f.newView(f.rerangePath(name), view.Name())
+	staging.broadcaster = NopBroadcaster
+	for _, frag := range frags {
+		if rerange.HasSlice(frag.Slice()) {
+			continue
+		}
+		path := staging.FragmentPath(frag.Slice())
+		for _, p := range []string{path, path + CacheExt} {
+			if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	if err := staging.Open(); err != nil {
+		return err
+	}
+	defer staging.Close()
+
+	// Re-encode each fragment into the staging view.
+	delta := uint64(field.Min) - uint64(other.Min)
+	for _, frag := range frags {
+		if rerange.HasSlice(frag.Slice()) {
+			continue
+		}
+
+		var rowIDs, columnIDs []uint64
+		for rowID, row := range frag.FieldRerangeRows(field.BitDepth(), other.BitDepth(), delta) {
+			for _, columnID := range row.Bits() {
+				rowIDs = append(rowIDs, uint64(rowID))
+				columnIDs = append(columnIDs, columnID)
+			}
+		}
+
+		dst, err := staging.CreateFragmentIfNotExists(frag.Slice())
+		if err != nil {
+			return err
+		} else if err := dst.Import(rowIDs, columnIDs); err != nil {
+			return err
+		}
+
+		// Record progress.
+		rerange = &FieldRerange{
+			Field:  other,
+			Slices: append(append([]uint64{}, rerange.Slices...), frag.Slice()),
+		}
+		if err := f.saveRerange(rerange); err != nil {
+			return err
+		}
+	}
+
+	if err := staging.Close(); err != nil {
+		return err
+	}
+	return f.commitRerange(rerange)
+}
+
+// saveRerange writes the progress of a re-range to the schema.
+func (f *Frame) saveRerange(r *FieldRerange) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	schema := f.schema.Clone()
+	schema.setRerange(r)
+	f.schema = schema
+	return f.saveSchema()
+}
+
+// commitRerange atomically writes the new field definition to the schema and
+// then replaces the field's view with the staging view. Queries reading the
+// current view are allowed to finish before it is closed, and new queries
+// wait until the field & its re-encoded view are both in place.
+func (f *Frame) commitRerange(r *FieldRerange) error {
+	f.fieldViewMu.Lock()
+	defer f.fieldViewMu.Unlock()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	schema := f.schema.Clone()
+	schema.replaceField(r.Field)
+	schema.setRerange(r)
+	f.schema = schema
+	if err := f.saveSchema(); err != nil {
+		return err
+	}
+	f.schemaVersion = nextSchemaVersion(f.schemaVersion)
+	if err := f.saveMeta(); err != nil {
+		return err
+	}
+
+	// Close the current view so that it can be replaced.
+	viewName := ViewFieldPrefix + r.Field.Name
+	if view := f.views[viewName]; view != nil {
+		delete(f.views, viewName)
+		if err := view.Close(); err != nil {
+			return err
+		}
+	}
+
+	if err := f.finishRerange(r.Field.Name); err != nil {
+		return err
+	}
+
+	// Reopen the re-encoded view, if there is one.
+	if _, err := os.Stat(f.ViewPath(viewName)); err == nil {
+		view := f.newView(f.ViewPath(viewName), viewName)
+		if err := view.Open(); err != nil {
+			return err
+		}
+		f.views[viewName] = view
+	}
+
+	return nil
+}
+
+// finishRerange moves the staging view of a committed re-range into place
+// and removes the re-range from the schema. This is safe to call repeatedly.
+func (f *Frame) finishRerange(name string) error {
+	staging := f.rerangePath(name)
+	if _, err := os.Stat(staging); err == nil {
+		path := f.ViewPath(ViewFieldPrefix + name)
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		} else if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			return err
+		} else if err := os.Rename(staging, path); err != nil {
+			return err
+		}
+	}
+
+	schema := f.schema.Clone()
+	schema.deleteRerange(name)
+	f.schema = schema
+	return f.saveSchema()
+}
+
+// recoverReranges completes any re-range which was committed to the schema
+// but not moved into place. Re-ranges which were not committed are left in
+// the schema so they can be resumed.
+func (f *Frame) recoverReranges() error {
+	for _, r := range f.schema.Reranges {
+		field := f.schema.fieldByName(r.Field.Name)
+		if field == nil {
+			if err := os.RemoveAll(f.rerangePath(r.Field.Name)); err != nil {
+				return err
+			}
+			schema := f.schema.Clone()
+			schema.deleteRerange(r.Field.Name)
+			f.schema = schema
+			if err := f.saveSchema(); err != nil {
+				return err
+			}
+		} else if *field == *r.Field {
+			if err := f.finishRerange(r.Field.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rerangePath returns the path to the staging view for re-ranging a field.
+func (f *Frame) rerangePath(name string) string {
+	return filepath.Join(f.path, ".rerange", name)
+}
+
 // TimeQuantum returns the time quantum for the frame.
 func (f *Frame) TimeQuantum() TimeQuantum {
 	f.mu.Lock()
@@ -507,7 +851,10 @@ func (f *Frame) SetTimeQuantum(q TimeQuantum) error {
 	}
 
 	// Update value on frame.
-	f.timeQuantum = q
+	if q != f.timeQuantum {
+		f.timeQuantum = q
+		f.schemaVersion = nextSchemaVersion(f.schemaVersion)
+	}
 
 	// Persist meta data to disk.
 	if err := f.saveMeta(); err != nil {
@@ -517,6 +864,31 @@ func (f *Frame) SetTimeQuantum(q TimeQuantum) error {
 	return nil
 }
 
+// SchemaVersion returns the version of the frame's time quantum & fields.
+func (f *Frame) SchemaVersion() uint64 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.schemaVersion
+}
+
+// nextSchemaVersion returns the version for a schema change following v.
+// Versions are based on the current time so that changes made on different
+// nodes are ordered by when they were made.
+func nextSchemaVersion(v uint64) uint64 {
+	if now := uint64(time.Now().UnixNano()); now > v {
+		return now
+	}
+	return v + 1
+}
+
+// setSchemaVersion sets the schema version after adopting a remote schema.
+func (f *Frame) setSchemaVersion(v uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.schemaVersion = v
+	return f.saveMeta()
+}
+
 // ViewPath returns the path to a view in the frame.
 func (f *Frame) ViewPath(name string) string {
 	return filepath.Join(f.path, "views", name)
@@ -577,13 +949,29 @@ func (f *Frame) CreateViewIfNotExists(name string) (*View, error) {
 func (f *Frame) newView(path, name string) *View {
 	view := NewView(path, f.index, f.name, name, f.cacheSize)
 	view.cacheType = f.cacheType
+	view.compression = f.viewCompression(name)
 	view.LogOutput = f.LogOutput
 	view.RowAttrStore = f.rowAttrStore
 	view.stats = f.Stats.WithTags(fmt.Sprintf("view:%s", name))
 	view.broadcaster = f.broadcaster
+	view.syncer = f.syncer
+	view.snapshotQueue = f.snapshotQueue
 	return view
 }
 
+// viewCompression returns the compression codec used by the named view.
+func (f *Frame) viewCompression(name string) string {
+	if len(f.compressedViews) == 0 {
+		return f.compression
+	}
+	for _, prefix := range f.compressedViews {
+		if strings.HasPrefix(name, prefix) {
+			return f.compression
+		}
+	}
+	return CompressionNone
+}
+
 // DeleteView removes the view from the frame.
 func (f *Frame) DeleteView(name string) error {
 	view := f.views[name]
@@ -626,6 +1014,13 @@ func (f *Frame) SetBit(name string, rowID, colID uint64, t *time.Time) (changed
 		changed = v
 	}
 
+	// Mark the column as existing.
+	if name == ViewStandard {
+		if err := f.setExists(colID); err != nil {
+			return changed, err
+		}
+	}
+
 	// Exit early if no timestamp is specified.
 	if t == nil {
 		return changed, nil
@@ -692,13 +1087,13 @@ func (f *Frame) ClearBit(name string, rowID, colID uint64, t *time.Time) (change
 
 // FieldValue reads a field value for a column.
 func (f *Frame) FieldValue(columnID uint64, name string) (value int64, exists bool, err error) {
-	field := f.Field(name)
+	field, view, release := f.fieldView(name)
+	defer release()
 	if field == nil {
 		return 0, false, ErrFieldNotFound
 	}
 
 	// Fetch target view.
-	view := f.View(ViewFieldPrefix + name)
 	if view == nil {
 		return 0, false, nil
 	}
@@ -714,6 +1109,9 @@ func (f *Frame) FieldValue(columnID uint64, name string) (value int64, exists bo
 
 // SetFieldValue sets a field value for a column.
 func (f *Frame) SetFieldValue(columnID uint64, name string, value int64) (changed bool, err error) {
+	f.fieldMu.RLock()
+	defer f.fieldMu.RUnlock()
+
 	// Fetch field and validate value.
 	field := f.Field(name)
 	if field == nil {
@@ -733,18 +1131,27 @@ func (f *Frame) SetFieldValue(columnID uint64, name string, value int64) (change
 	// Determine base value to store.
 	baseValue := uint64(value - field.Min)
 
-	return view.SetFieldValue(columnID, field.BitDepth(), baseValue)
+	if changed, err = view.SetFieldValue(columnID, field.BitDepth(), baseValue); err != nil {
+		return changed, err
+	}
+
+	// Mark the column as existing.
+	if err := f.setExists(columnID); err != nil {
+		return changed, err
+	}
+
+	return changed, nil
 }
 
 // FieldSum returns the sum and count for a field.
 // An optional filtering bitmap can be provided.
 func (f *Frame) FieldSum(filter *Bitmap, name string) (sum, count int64, err error) {
-	field := f.Field(name)
+	field, view, release := f.fieldView(name)
+	defer release()
 	if field == nil {
 		return 0, 0, ErrFieldNotFound
 	}
 
-	view := f.View(ViewFieldPrefix + name)
 	if view == nil {
 		return 0, 0, nil
 	}
@@ -758,7 +1165,8 @@ func (f *Frame) FieldSum(filter *Bitmap, name string) (sum, count int64, err err
 
 func (f *Frame) FieldRange(name string, op pql.Token, predicate int64) (*Bitmap, error) {
 	// Retrieve and validate field.
-	field := f.Field(name)
+	field, view, release := f.fieldView(name)
+	defer release()
 	if field == nil {
 		return nil, ErrFieldNotFound
 	} else if predicate < field.Min || predicate > field.Max {
@@ -766,7 +1174,6 @@ func (f *Frame) FieldRange(name string, op pql.Token, predicate int64) (*Bitmap,
 	}
 
 	// Retrieve field's view.
-	view := f.View(ViewFieldPrefix + name)
 	if view == nil {
 		return nil, nil
 	}
@@ -781,7 +1188,8 @@ func (f *Frame) FieldRange(name string, op pql.Token, predicate int64) (*Bitmap,
 
 func (f *Frame) FieldRangeBetween(name string, predicateMin, predicateMax int64) (*Bitmap, error) {
 	// Retrieve and validate field.
-	field := f.Field(name)
+	field, view, release := f.fieldView(name)
+	defer release()
 	if field == nil {
 		return nil, ErrFieldNotFound
 	} else if predicateMin > predicateMax {
@@ -789,7 +1197,6 @@ func (f *Frame) FieldRangeBetween(name string, predicateMin, predicateMax int64)
 	}
 
 	// Retrieve field's view.
-	view := f.View(ViewFieldPrefix + name)
 	if view == nil {
 		return nil, nil
 	}
@@ -878,6 +1285,11 @@ func (f *Frame) Import(rowIDs, columnIDs []uint64, timestamps []*time.Time) erro
 		}
 	}
 
+	// Mark all imported columns as existing.
+	if err := f.importExists(columnIDs); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -888,6 +1300,9 @@ func (f *Frame) ImportValue(fieldName string, columnIDs []uint64, values []int64
 		return fmt.Errorf("Frame not RangeEnabled: %s", f.name)
 	}
 
+	f.fieldMu.RLock()
+	defer f.fieldMu.RUnlock()
+
 	viewName := ViewFieldPrefix + fieldName
 	// Get the field so we know bitDepth.
 	field := f.Field(fieldName)
@@ -940,6 +1355,46 @@ func (f *Frame) ImportValue(fieldName string, columnIDs []uint64, values []int64
 		}
 	}
 
+	// Mark all imported columns as existing.
+	if err := f.importExists(columnIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setExists marks a column as existing in the index's existence view.
+func (f *Frame) setExists(columnID uint64) error {
+	if f.existence == nil {
+		return nil
+	}
+	_, err := f.existence.SetBit(0, columnID)
+	return err
+}
+
+// importExists bulk marks columns as existing in the index's existence view.
+func (f *Frame) importExists(columnIDs []uint64) error {
+	if f.existence == nil {
+		return nil
+	}
+
+	// Split column ids by slice.
+	columnIDsBySlice := make(map[uint64][]uint64)
+	for _, columnID := range columnIDs {
+		slice := columnID / SliceWidth
+		columnIDsBySlice[slice] = append(columnIDsBySlice[slice], columnID)
+	}
+
+	// Import into each fragment on row zero.
+	for slice, columnIDs := range columnIDsBySlice {
+		frag, err := f.existence.CreateFragmentIfNotExists(slice)
+		if err != nil {
+			return err
+		}
+		if err := frag.Import(make([]uint64, len(columnIDs)), columnIDs); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -954,10 +1409,15 @@ func encodeFrames(a []*Frame) []*internal.Frame {
 
 // encodeFrame converts f into its internal representation.
 func encodeFrame(f *Frame) *internal.Frame {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
 	fo := f.options()
+	pb := fo.Encode()
+	pb.SchemaVersion = f.schemaVersion
 	return &internal.Frame{
 		Name: f.name,
-		Meta: fo.Encode(),
+		Meta: pb,
 	}
 }
 
@@ -988,33 +1448,60 @@ type FrameOptions struct {
 	CacheSize      uint32      `json:"cacheSize,omitempty"`
 	TimeQuantum    TimeQuantum `json:"timeQuantum,omitempty"`
 	Fields         []*Field    `json:"fields,omitempty"`
+	Keys           bool        `json:"keys,omitempty"`
+
+	// Compression is the codec used for fragment snapshots. If
+	// CompressedViews is set, only views beginning with one of its
+	// prefixes are compressed.
+	Compression     string   `json:"compression,omitempty"`
+	CompressedViews []string `json:"compressedViews,omitempty"`
 }
 
 // Encode converts o into its internal representation.
 func (o *FrameOptions) Encode() *internal.FrameMeta {
 	return &internal.FrameMeta{
-		RowLabel:       o.RowLabel,
-		InverseEnabled: o.InverseEnabled,
-		RangeEnabled:   o.RangeEnabled,
-		CacheType:      o.CacheType,
-		CacheSize:      o.CacheSize,
-		TimeQuantum:    string(o.TimeQuantum),
-		Fields:         encodeFields(o.Fields),
+		RowLabel:        o.RowLabel,
+		InverseEnabled:  o.InverseEnabled,
+		RangeEnabled:    o.RangeEnabled,
+		CacheType:       o.CacheType,
+		CacheSize:       o.CacheSize,
+		TimeQuantum:     string(o.TimeQuantum),
+		Fields:          encodeFields(o.Fields),
+		Keys:            o.Keys,
+		Compression:     o.Compression,
+		CompressedViews: o.CompressedViews,
 	}
 }
 
 // FrameSchema represents the list of fields on a frame.
 type FrameSchema struct {
 	Fields []*Field
+
+	// Re-encodings of field views which have not completed.
+	Reranges []*FieldRerange
 }
 
 // Clone returns a copy of s.
 func (s *FrameSchema) Clone() *FrameSchema {
 	other := &FrameSchema{Fields: make([]*Field, len(s.Fields))}
 	copy(other.Fields, s.Fields)
+	if len(s.Reranges) > 0 {
+		other.Reranges = make([]*FieldRerange, len(s.Reranges))
+		copy(other.Reranges, s.Reranges)
+	}
 	return other
 }
 
+// fieldByName returns a field from the schema by name.
+func (s *FrameSchema) fieldByName(name string) *Field {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
 // HasField returns true if a field exists on the schema.
 func (s *FrameSchema) HasField(name string) bool {
 	for _, f := range s.Fields {
@@ -1056,12 +1543,58 @@ func (s *FrameSchema) DeleteField(name string) error {
 	return ErrFieldNotFound
 }
 
+// Rerange returns the in-progress re-encoding of a field, if any.
+func (s *FrameSchema) Rerange(name string) *FieldRerange {
+	for _, r := range s.Reranges {
+		if r.Field.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// setRerange adds or replaces the re-encoding of a field.
+func (s *FrameSchema) setRerange(r *FieldRerange) {
+	for i := range s.Reranges {
+		if s.Reranges[i].Field.Name == r.Field.Name {
+			s.Reranges[i] = r
+			return
+		}
+	}
+	s.Reranges = append(s.Reranges, r)
+}
+
+// deleteRerange removes the re-encoding of a field, if any.
+func (s *FrameSchema) deleteRerange(name string) {
+	for i, r := range s.Reranges {
+		if r.Field.Name == name {
+			copy(s.Reranges[i:], s.Reranges[i+1:])
+			s.Reranges, s.Reranges[len(s.Reranges)-1] = s.Reranges[:len(s.Reranges)-1], nil
+			if len(s.Reranges) == 0 {
+				s.Reranges = nil
+			}
+			return
+		}
+	}
+}
+
+// replaceField swaps the field with the same name for field.
+func (s *FrameSchema) replaceField(field *Field) {
+	for i := range s.Fields {
+		if s.Fields[i].Name == field.Name {
+			s.Fields[i] = field
+			return
+		}
+	}
+}
+
 func encodeFrameSchema(schema *FrameSchema) *internal.FrameSchema {
 	if schema == nil {
 		return nil
 	}
 	return &internal.FrameSchema{
-		Fields: encodeFields(schema.Fields),
+		Fields:   encodeFields(schema.Fields),
+		Reranges: encodeFieldReranges(schema.Reranges),
 	}
 }
 
@@ -1070,18 +1603,67 @@ func decodeFrameSchema(schema *internal.FrameSchema) *FrameSchema {
 		return nil
 	}
 	return &FrameSchema{
-		Fields: decodeFields(schema.Fields),
+		Fields:   decodeFields(schema.Fields),
+		Reranges: decodeFieldReranges(schema.Reranges),
+	}
+}
+
+// FieldRerange tracks the progress of re-encoding a field's view for a new
+// range. Field is the target definition and Slices lists the slices which
+// have already been re-encoded.
+type FieldRerange struct {
+	Field  *Field
+	Slices []uint64
+}
+
+// HasSlice returns true if slice has already been re-encoded.
+func (r *FieldRerange) HasSlice(slice uint64) bool {
+	for _, s := range r.Slices {
+		if s == slice {
+			return true
+		}
 	}
+	return false
+}
+
+func encodeFieldReranges(a []*FieldRerange) []*internal.FieldRerange {
+	if len(a) == 0 {
+		return nil
+	}
+	other := make([]*internal.FieldRerange, len(a))
+	for i := range a {
+		other[i] = &internal.FieldRerange{
+			Field:  encodeField(a[i].Field),
+			Slices: a[i].Slices,
+		}
+	}
+	return other
+}
+
+func decodeFieldReranges(a []*internal.FieldRerange) []*FieldRerange {
+	if len(a) == 0 {
+		return nil
+	}
+	other := make([]*FieldRerange, len(a))
+	for i := range a {
+		other[i] = &FieldRerange{
+			Field:  decodeField(a[i].Field),
+			Slices: a[i].Slices,
+		}
+	}
+	return other
 }
 
 // List of field data types.
 const (
-	FieldTypeInt = "int"
+	FieldTypeInt       = "int"
+	FieldTypeDecimal   = "decimal"
+	FieldTypeTimestamp = "timestamp"
 )
 
 func IsValidFieldType(v string) bool {
 	switch v {
-	case FieldTypeInt:
+	case FieldTypeInt, FieldTypeDecimal, FieldTypeTimestamp:
 		return true
 	default:
 		return false
@@ -1089,11 +1671,177 @@ func IsValidFieldType(v string) bool {
 }
 
 // Field represents a range field on a frame.
+//
+// Decimal fields store values as integers scaled by 10^Scale so Min, Max and
+// all stored values are in scaled units. Timestamp fields store values as the
+// number of Units since the Unix epoch. The JSON representation of a field
+// expresses Min & Max as decimals or timestamps.
 type Field struct {
-	Name string `json:"name,omitempty"`
-	Type string `json:"type,omitempty"`
-	Min  int64  `json:"min,omitempty"`
-	Max  int64  `json:"max,omitempty"`
+	Name  string
+	Type  string
+	Min   int64
+	Max   int64
+	Scale uint32
+	Unit  string
+}
+
+// fieldJSON is the JSON representation of a field.
+type fieldJSON struct {
+	Name  string          `json:"name,omitempty"`
+	Type  string          `json:"type,omitempty"`
+	Min   json.RawMessage `json:"min,omitempty"`
+	Max   json.RawMessage `json:"max,omitempty"`
+	Scale uint32          `json:"scale,omitempty"`
+	Unit  string          `json:"unit,omitempty"`
+}
+
+// MarshalJSON encodes f with Min & Max formatted as decimals or timestamps.
+func (f *Field) MarshalJSON() ([]byte, error) {
+	v := fieldJSON{Name: f.Name, Type: f.Type, Scale: f.Scale, Unit: f.Unit}
+	if f.Min != 0 {
+		v.Min = f.marshalJSONValue(f.Min)
+	}
+	if f.Max != 0 {
+		v.Max = f.marshalJSONValue(f.Max)
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes f and converts Min & Max into the field's units.
+func (f *Field) UnmarshalJSON(data []byte) error {
+	var v fieldJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	other := Field{Name: v.Name, Type: v.Type, Scale: v.Scale, Unit: v.Unit}
+	if other.Scale > MaxFieldScale {
+		return ErrInvalidFieldScale
+	} else if !IsValidTimeUnit(other.Unit) {
+		return ErrInvalidFieldUnit
+	}
+	if len(v.Min) > 0 {
+		min, err := other.unmarshalJSONValue(v.Min)
+		if err != nil {
+			return err
+		}
+		other.Min = min
+	}
+	if len(v.Max) > 0 {
+		max, err := other.unmarshalJSONValue(v.Max)
+		if err != nil {
+			return err
+		}
+		other.Max = max
+	}
+	*f = other
+	return nil
+}
+
+// marshalJSONValue encodes v as a JSON timestamp string or decimal number.
+func (f *Field) marshalJSONValue(v int64) json.RawMessage {
+	if f.Type == FieldTypeTimestamp {
+		return json.RawMessage(strconv.Quote(FormatTimestamp(v, f.Unit)))
+	}
+	return json.RawMessage(FormatDecimal(v, f.Scale))
+}
+
+// unmarshalJSONValue converts a JSON number or string into the field's units.
+func (f *Field) unmarshalJSONValue(data json.RawMessage) (int64, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return 0, err
+	}
+
+	switch v := v.(type) {
+	case json.Number:
+		return ParseDecimal(v.String(), f.Scale)
+	case string:
+		if f.Type == FieldTypeTimestamp {
+			return ParseTimestamp(v, f.Unit)
+		}
+		return ParseDecimal(v, f.Scale)
+	default:
+		return 0, ErrInvalidFieldValueType
+	}
+}
+
+// ParseValue converts a PQL value into the field's scaled units.
+// Integer fields only accept integers. Decimal fields also accept floats &
+// decimal strings; strings are converted without loss of precision.
+// Timestamp fields also accept RFC3339 strings.
+func (f *Field) ParseValue(v interface{}) (int64, error) {
+	if f.Type == FieldTypeTimestamp {
+		if s, ok := v.(string); ok {
+			return ParseTimestamp(s, f.Unit)
+		}
+	}
+	if f.Type != FieldTypeDecimal {
+		if v, ok := v.(int64); ok {
+			return v, nil
+		}
+		return 0, ErrInvalidFieldValueType
+	}
+
+	switch v := v.(type) {
+	case int64:
+		return ParseDecimal(strconv.FormatInt(v, 10), f.Scale)
+	case float64:
+		return ParseDecimal(strconv.FormatFloat(v, 'f', -1, 64), f.Scale)
+	case string:
+		return ParseDecimal(v, f.Scale)
+	default:
+		return 0, ErrInvalidFieldValueType
+	}
+}
+
+// ImportValues converts imported values into the field's scaled units.
+// If decimalValues is set then it is used in place of values for decimal
+// fields. Integer fields only accept decimal values which equal their
+// integer value.
+func (f *Field) ImportValues(values []int64, decimalValues []string) ([]int64, error) {
+	if len(decimalValues) == 0 {
+		if f.Type != FieldTypeDecimal {
+			return values, nil
+		}
+		other := make([]int64, len(values))
+		for i, v := range values {
+			value, err := f.ParseValue(v)
+			if err != nil {
+				return nil, err
+			}
+			other[i] = value
+		}
+		return other, nil
+	}
+
+	if f.Type != FieldTypeDecimal {
+		if len(values) != len(decimalValues) {
+			return nil, ErrInvalidFieldValueType
+		}
+		for i, v := range decimalValues {
+			if value, err := strconv.ParseInt(v, 10, 64); err != nil || value != values[i] {
+				return nil, ErrInvalidFieldValueType
+			}
+		}
+		return values, nil
+	}
+
+	other := make([]int64, len(decimalValues))
+	for i, v := range decimalValues {
+		value, err := f.ParseValue(v)
+		if err != nil {
+			return nil, err
+		}
+		other[i] = value
+	}
+	return other, nil
+}
+
+// FormatValue returns the string representation of a value in scaled units.
+func (f *Field) FormatValue(v int64) string {
+	return FormatDecimal(v, f.Scale)
 }
 
 // BitDepth returns the number of bits required to store a value between min & max.
@@ -1163,6 +1911,10 @@ func ValidateField(f *Field) error {
 		return ErrFieldNameRequired
 	} else if !IsValidFieldType(f.Type) {
 		return ErrInvalidFieldType
+	} else if f.Scale > MaxFieldScale || (f.Scale > 0 && f.Type != FieldTypeDecimal) {
+		return ErrInvalidFieldScale
+	} else if !IsValidTimeUnit(f.Unit) || (f.Unit != "" && f.Type != FieldTypeTimestamp) {
+		return ErrInvalidFieldUnit
 	} else if f.Min > f.Max {
 		return ErrInvalidFieldRange
 	}
@@ -1196,10 +1948,12 @@ func encodeField(f *Field) *internal.Field {
 		return nil
 	}
 	return &internal.Field{
-		Name: f.Name,
-		Type: f.Type,
-		Min:  int64(f.Min),
-		Max:  int64(f.Max),
+		Name:  f.Name,
+		Type:  f.Type,
+		Min:   int64(f.Min),
+		Max:   int64(f.Max),
+		Scale: f.Scale,
+		Unit:  f.Unit,
 	}
 }
 
@@ -1208,10 +1962,12 @@ func decodeField(f *internal.Field) *Field {
 		return nil
 	}
 	return &Field{
-		Name: f.Name,
-		Type: f.Type,
-		Min:  f.Min,
-		Max:  f.Max,
+		Name:  f.Name,
+		Type:  f.Type,
+		Min:   f.Min,
+		Max:   f.Max,
+		Scale: f.Scale,
+		Unit:  f.Unit,
 	}
 }
 
@@ -1235,6 +1991,22 @@ const (
 	CacheTypeNone   = "none"
 )
 
+// Compression codecs.
+const (
+	CompressionNone = ""
+	CompressionGzip = "gzip"
+)
+
+// IsValidCompression returns true if v is a valid compression codec.
+func IsValidCompression(v string) bool {
+	switch v {
+	case CompressionNone, CompressionGzip:
+		return true
+	default:
+		return false
+	}
+}
+
 // IsValidCacheType returns true if v is a valid cache type.
 func IsValidCacheType(v string) bool {
 	switch v {
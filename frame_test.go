@@ -15,6 +15,7 @@
 package pilosa_test
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"reflect"
 	"testing"
@@ -70,6 +71,175 @@ func TestFrame_SetTimeQuantum(t *testing.T) {
 	}
 }
 
+// Ensure a frame can delete a field and persist the change.
+func TestFrame_DeleteField(t *testing.T) {
+	idx := test.MustOpenIndex()
+	defer idx.Close()
+
+	f, err := idx.CreateFrame("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields: []*pilosa.Field{
+			{Name: "field0", Type: pilosa.FieldTypeInt, Min: 0, Max: 30},
+			{Name: "field1", Type: pilosa.FieldTypeInt, Min: 20, Max: 25},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := f.DeleteField("field0"); err != nil {
+		t.Fatal(err)
+	} else if f.Field("field0") != nil {
+		t.Fatal("expected field to be deleted")
+	}
+
+	// Reload index and verify that the deletion is persisted.
+	if err := idx.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if f := idx.Frame("f"); f.Field("field0") != nil {
+		t.Fatal("expected field to be deleted (reopen)")
+	} else if f.Field("field1") == nil {
+		t.Fatal("expected field1 to exist (reopen)")
+	}
+}
+
+// Ensure a field can be re-ranged without losing existing values.
+func TestFrame_RerangeField(t *testing.T) {
+	idx := test.MustOpenIndex()
+	defer idx.Close()
+
+	f, err := idx.CreateFrame("f", pilosa.FrameOptions{
+		RangeEnabled: true,
+		Fields: []*pilosa.Field{
+			{Name: "field0", Type: pilosa.FieldTypeInt, Min: 10, Max: 30},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := map[uint64]int64{1: 10, 2: 17, 3: 30, pilosa.SliceWidth + 1: 25}
+	for columnID, v := range values {
+		if _, err := f.SetFieldValue(columnID, "field0", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Ensure the range cannot exclude existing values.
+	if err := f.RerangeField("field0", 11, 30); err != pilosa.ErrFieldValueTooLow {
+		t.Fatalf("unexpected error: %v", err)
+	} else if err := f.RerangeField("field0", 0, 29); err != pilosa.ErrFieldValueTooHigh {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Widen the range & shift the base below zero.
+	if err := f.RerangeField("field0", -1000, 100000); err != nil {
+		t.Fatal(err)
+	} else if field := f.Field("field0"); field.Min != -1000 || field.Max != 100000 {
+		t.Fatalf("unexpected field: %#v", field)
+	}
+
+	for columnID, v := range values {
+		if value, exists, err := f.FieldValue(columnID, "field0"); err != nil {
+			t.Fatal(err)
+		} else if !exists || value != v {
+			t.Fatalf("unexpected value: column=%d, value=%d, exists=%v", columnID, value, exists)
+		}
+	}
+	if sum, count, err := f.FieldSum(nil, "field0"); err != nil {
+		t.Fatal(err)
+	} else if sum != 82 || count != 4 {
+		t.Fatalf("unexpected sum: %d, %d", sum, count)
+	}
+
+	// Ensure new values can use the wider range.
+	if _, err := f.SetFieldValue(4, "field0", -500); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetFieldValue(4, "field0", 17); err != nil {
+		t.Fatal(err)
+	}
+	values[4] = 17
+
+	// Narrow the range back around the existing values.
+	if err := f.RerangeField("field0", 10, 30); err != nil {
+		t.Fatal(err)
+	}
+	if sum, _, err := f.FieldSum(nil, "field0"); err != nil {
+		t.Fatal(err)
+	} else if sum != 99 {
+		t.Fatalf("unexpected sum: %d", sum)
+	}
+
+	// Reload index and verify that the new range and data are persisted.
+	if err := idx.Reopen(); err != nil {
+		t.Fatal(err)
+	}
+	other := idx.Frame("f")
+	if field := other.Field("field0"); field.Min != 10 || field.Max != 30 {
+		t.Fatalf("unexpected field (reopen): %#v", field)
+	} else if len(other.Schema().Reranges) != 0 {
+		t.Fatalf("unexpected reranges (reopen): %#v", other.Schema().Reranges)
+	}
+	for columnID, v := range values {
+		if value, exists, err := other.FieldValue(columnID, "field0"); err != nil {
+			t.Fatal(err)
+		} else if !exists || value != v {
+			t.Fatalf("unexpected value (reopen): column=%d, value=%d, exists=%v", columnID, value, exists)
+		}
+	}
+}
+
+// Ensure a decimal field encodes its range as decimals.
+func TestField_JSON(t *testing.T) {
+	field := &pilosa.Field{Name: "price", Type: pilosa.FieldTypeDecimal, Scale: 2, Min: -150, Max: 100000}
+	buf, err := json.Marshal(field)
+	if err != nil {
+		t.Fatal(err)
+	} else if string(buf) != `{"name":"price","type":"decimal","min":-1.50,"max":1000.00,"scale":2}` {
+		t.Fatalf("unexpected json: %s", buf)
+	}
+
+	var other pilosa.Field
+	if err := json.Unmarshal([]byte(`{"name":"price","type":"decimal","scale":2,"min":-1.5,"max":1000}`), &other); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(&other, field) {
+		t.Fatalf("unexpected field: %#v", other)
+	}
+
+	// Integer fields are unchanged.
+	if buf, err := json.Marshal(&pilosa.Field{Name: "x", Type: pilosa.FieldTypeInt, Max: 100}); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != `{"name":"x","type":"int","max":100}` {
+		t.Fatalf("unexpected json: %s", buf)
+	}
+
+	// Timestamp fields encode their range as RFC3339 strings.
+	ts := &pilosa.Field{Name: "t", Type: pilosa.FieldTypeTimestamp, Unit: pilosa.TimeUnitMilliseconds, Min: -500, Max: 1483228800000}
+	if buf, err := json.Marshal(ts); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != `{"name":"t","type":"timestamp","min":"1969-12-31T23:59:59.5Z","max":"2017-01-01T00:00:00Z","unit":"ms"}` {
+		t.Fatalf("unexpected json: %s", buf)
+	}
+	other = pilosa.Field{}
+	if err := json.Unmarshal([]byte(`{"name":"t","type":"timestamp","unit":"ms","min":-500,"max":"2017-01-01T00:00"}`), &other); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(&other, ts) {
+		t.Fatalf("unexpected field: %#v", other)
+	}
+
+	// Scale is only valid for decimal fields.
+	if err := pilosa.ValidateField(&pilosa.Field{Name: "x", Type: pilosa.FieldTypeInt, Scale: 2}); err != pilosa.ErrInvalidFieldScale {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Unit is only valid for timestamp fields.
+	if err := pilosa.ValidateField(&pilosa.Field{Name: "x", Type: pilosa.FieldTypeInt, Unit: pilosa.TimeUnitSeconds}); err != pilosa.ErrInvalidFieldUnit {
+		t.Fatalf("unexpected error: %v", err)
+	} else if err := pilosa.ValidateField(&pilosa.Field{Name: "x", Type: pilosa.FieldTypeTimestamp, Unit: "h"}); err != pilosa.ErrInvalidFieldUnit {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 // Ensure a frame can set & read a field value.
 func TestFrame_SetFieldValue(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
@@ -65,6 +65,13 @@ type Handler struct {
 	// The execution engine for running queries.
 	Executor interface {
 		Execute(context context.Context, index string, query *pql.Query, slices []uint64, opt *ExecOptions) ([]interface{}, error)
+		Explain(context context.Context, index string, query *pql.Query, slices []uint64, opt *ExecOptions) ([]*CallPlan, error)
+	}
+
+	// Translates string keys to IDs for imports.
+	Translator interface {
+		TranslateKeys(ctx context.Context, index, frame string, keys []string) ([]uint64, error)
+		TranslateIDs(ctx context.Context, index, frame string, ids []uint64) ([]string, error)
 	}
 
 	// The writer for any logging.
@@ -113,13 +120,18 @@ func NewRouter(handler *Handler) *mux.Router {
 	router.HandleFunc("/index/{index}", handler.handlePostIndex).Methods("POST")
 	router.HandleFunc("/index/{index}", handler.handleDeleteIndex).Methods("DELETE")
 	router.HandleFunc("/index/{index}/attr/diff", handler.handlePostIndexAttrDiff).Methods("POST")
+	router.HandleFunc("/index/{index}/attr-index/{attr}", handler.handlePostAttrIndex).Methods("POST")
+	router.HandleFunc("/index/{index}/attr-index/{attr}", handler.handleDeleteAttrIndex).Methods("DELETE")
 	//router.HandleFunc("/index/{index}/frame", handler.handleGetFrames).Methods("GET") // Not implemented.
 	router.HandleFunc("/index/{index}/frame/{frame}", handler.handlePostFrame).Methods("POST")
 	router.HandleFunc("/index/{index}/frame/{frame}", handler.handleDeleteFrame).Methods("DELETE")
 	router.HandleFunc("/index/{index}/frame/{frame}/attr/diff", handler.handlePostFrameAttrDiff).Methods("POST")
+	router.HandleFunc("/index/{index}/frame/{frame}/attr-index/{attr}", handler.handlePostAttrIndex).Methods("POST")
+	router.HandleFunc("/index/{index}/frame/{frame}/attr-index/{attr}", handler.handleDeleteAttrIndex).Methods("DELETE")
 	router.HandleFunc("/index/{index}/frame/{frame}/restore", handler.handlePostFrameRestore).Methods("POST")
 	router.HandleFunc("/index/{index}/frame/{frame}/time-quantum", handler.handlePatchFrameTimeQuantum).Methods("PATCH")
 	router.HandleFunc("/index/{index}/frame/{frame}/field/{field}", handler.handlePostFrameField).Methods("POST")
+	router.HandleFunc("/index/{index}/frame/{frame}/field/{field}", handler.handlePatchFrameField).Methods("PATCH")
 	router.HandleFunc("/index/{index}/frame/{frame}/fields", handler.handleGetFrameFields).Methods("GET")
 	router.HandleFunc("/index/{index}/frame/{frame}/field/{field}", handler.handleDeleteFrameField).Methods("DELETE")
 	router.HandleFunc("/index/{index}/frame/{frame}/views", handler.handleGetFrameViews).Methods("GET")
@@ -136,6 +148,8 @@ func NewRouter(handler *Handler) *mux.Router {
 	router.HandleFunc("/status", handler.handleGetStatus).Methods("GET")
 	router.HandleFunc("/version", handler.handleGetVersion).Methods("GET")
 	router.HandleFunc("/recalculate-caches", handler.handleRecalculateCaches).Methods("POST")
+	router.HandleFunc("/translate/keys", handler.handlePostTranslateKeys).Methods("POST")
+	router.HandleFunc("/translate/ids", handler.handlePostTranslateIDs).Methods("POST")
 
 	// TODO: Apply MethodNotAllowed statuses to all endpoints.
 	// Ideally this would be automatic, as described in this (wontfix) ticket:
@@ -246,6 +260,10 @@ func (h *Handler) handlePostQuery(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		h.writeQueryResponse(w, r, &QueryResponse{Err: err})
 		return
+	} else if req.BitmapFormat != "" && !IsValidBitmapFormat(req.BitmapFormat) {
+		w.WriteHeader(http.StatusBadRequest)
+		h.writeQueryResponse(w, r, &QueryResponse{Err: ErrInvalidBitmapFormat})
+		return
 	}
 
 	// Build execution options.
@@ -253,20 +271,64 @@ func (h *Handler) handlePostQuery(w http.ResponseWriter, r *http.Request) {
 		Remote:       req.Remote,
 		ExcludeAttrs: req.ExcludeAttrs,
 		ExcludeBits:  req.ExcludeBits,
+		AttrKeys:     req.AttrKeys,
+		Limit:        req.Limit,
+		Offset:       req.Offset,
+		Cursor:       req.Cursor,
+		QueryID:      req.QueryID,
+	}
+
+	// The request context is canceled if the client disconnects so
+	// all local & remote work for the query is stopped.
+	ctx := r.Context()
+	if req.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, req.Timeout)
+		defer cancel()
+	}
+
+	// Start profiling, if requested.
+	var prof *Profile
+	if req.Profile {
+		var host string
+		if h.URI != nil {
+			host = h.URI.HostPort()
+		}
+		prof = NewProfile("query", host)
+		ctx = WithProfile(ctx, prof)
 	}
 
 	// Parse query string.
+	parseProf := prof.Start(&Profile{Name: "parse"})
 	q, err := pql.NewParser(strings.NewReader(req.Query)).Parse()
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		h.writeQueryResponse(w, r, &QueryResponse{Err: err})
 		return
 	}
+	parseProf.Finish(nil)
+
+	// Return the query plan instead of executing, if requested.
+	if req.Explain {
+		h.handlePostQueryExplain(w, r, indexName, q, req, opt)
+		return
+	}
 
 	// Execute the query.
-	results, err := h.Executor.Execute(r.Context(), indexName, q, req.Slices, opt)
+	results, err := h.Executor.Execute(ctx, indexName, q, req.Slices, opt)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		// Report the timeout even if it surfaced as a different error.
+		err = context.DeadlineExceeded
+	}
 	resp := &QueryResponse{Results: results, Err: err}
 
+	// Only attach the profile on success since failed queries may leave
+	// slices running in the background.
+	if err == nil && prof != nil {
+		prof.Finish(nil)
+		resp.Profile = prof
+	}
+
 	// Fill column attributes if requested.
 	if req.ColumnAttrs && !req.ExcludeBits {
 		// Consolidate all column ids across all calls.
@@ -279,21 +341,63 @@ func (h *Handler) handlePostQuery(w http.ResponseWriter, r *http.Request) {
 			columnIDs = uint64Slice(columnIDs).merge(bm.Bits())
 		}
 
+		// Only fetch attributes for the requested page of columns.
+		columnIDs = pageUint64s(columnIDs, req.ColumnAttrsOffset, req.ColumnAttrsLimit)
+
 		// Retrieve column attributes across all calls.
-		columnAttrSets, err := h.readColumnAttrSets(h.Holder.Index(indexName), columnIDs)
+		columnAttrSets, err := h.readColumnAttrSets(h.Holder.Index(indexName), columnIDs, req.AttrKeys)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			h.writeQueryResponse(w, r, &QueryResponse{Err: err})
 			return
 		}
+
+		// Attach column keys, if enabled.
+		if idx := h.Holder.Index(indexName); idx != nil && idx.Keys() && !req.Remote && len(columnAttrSets) > 0 {
+			ids := make([]uint64, len(columnAttrSets))
+			for i, set := range columnAttrSets {
+				ids[i] = set.ID
+			}
+			keys, err := h.Translator.TranslateIDs(r.Context(), indexName, "", ids)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				h.writeQueryResponse(w, r, &QueryResponse{Err: err})
+				return
+			}
+			for i, set := range columnAttrSets {
+				set.Key = keys[i]
+			}
+		}
 		resp.ColumnAttrSets = columnAttrSets
 	}
 
+	// Serialize bitmap results, if requested. Remote nodes always return
+	// plain bits so the results can be merged by the originating node.
+	if req.BitmapFormat != "" && !req.Remote && !req.ExcludeBits {
+		for _, result := range results {
+			bm, ok := result.(*Bitmap)
+			if !ok {
+				continue
+			}
+			data, err := bm.MarshalRoaring(req.BitmapFormat)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				h.writeQueryResponse(w, r, &QueryResponse{Err: err})
+				return
+			}
+			bm.Roaring, bm.segments = data, nil
+		}
+	}
+
 	// Set appropriate status code, if there is an error.
 	if resp.Err != nil {
 		switch resp.Err {
 		case ErrTooManyWrites:
 			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		case ErrInvalidCursor:
+			w.WriteHeader(http.StatusBadRequest)
+		case context.DeadlineExceeded:
+			w.WriteHeader(http.StatusGatewayTimeout)
 		default:
 			w.WriteHeader(http.StatusInternalServerError)
 		}
@@ -524,6 +628,16 @@ func (h *Handler) handlePatchIndexTimeQuantum(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Send the time quantum message to all nodes.
+	err = h.Broadcaster.SendSync(
+		&internal.SetIndexTimeQuantumMessage{
+			Index:       indexName,
+			TimeQuantum: string(tq),
+		})
+	if err != nil {
+		h.logger().Printf("problem sending SetIndexTimeQuantum message: %s", err)
+	}
+
 	// Encode response.
 	if err := json.NewEncoder(w).Encode(patchIndexTimeQuantumResponse{}); err != nil {
 		h.logger().Printf("response encoding error: %s", err)
@@ -763,6 +877,17 @@ func (h *Handler) handlePatchFrameTimeQuantum(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Send the time quantum message to all nodes.
+	err = h.Broadcaster.SendSync(
+		&internal.SetFrameTimeQuantumMessage{
+			Index:       indexName,
+			Frame:       frameName,
+			TimeQuantum: string(tq),
+		})
+	if err != nil {
+		h.logger().Printf("problem sending SetFrameTimeQuantum message: %s", err)
+	}
+
 	// Encode response.
 	if err := json.NewEncoder(w).Encode(patchFrameTimeQuantumResponse{}); err != nil {
 		h.logger().Printf("response encoding error: %s", err)
@@ -782,11 +907,12 @@ func (h *Handler) handlePostFrameField(w http.ResponseWriter, r *http.Request) {
 	fieldName := mux.Vars(r)["field"]
 
 	// Decode request.
-	var req postFrameFieldRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	var field Field
+	if err := json.NewDecoder(r.Body).Decode(&field); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	field.Name = fieldName
 
 	// Retrieve frame by name.
 	f := h.Holder.Frame(indexName, frameName)
@@ -796,29 +922,105 @@ func (h *Handler) handlePostFrameField(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create new field.
-	if err := f.CreateField(&Field{
-		Name: fieldName,
-		Type: req.Type,
-		Min:  req.Min,
-		Max:  req.Max,
-	}); err != nil {
+	if err := f.CreateField(&field); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	// Send the create field message to all nodes.
+	err := h.Broadcaster.SendSync(
+		&internal.CreateFieldMessage{
+			Index: indexName,
+			Frame: frameName,
+			Field: encodeField(&field),
+		})
+	if err != nil {
+		h.logger().Printf("problem sending CreateField message: %s", err)
+	}
+
 	// Encode response.
 	if err := json.NewEncoder(w).Encode(postFrameFieldResponse{}); err != nil {
 		h.logger().Printf("response encoding error: %s", err)
 	}
 }
 
-type postFrameFieldRequest struct {
-	Type string `json:"type,omitempty"`
-	Min  int64  `json:"min,omitempty"`
-	Max  int64  `json:"max,omitempty"`
+type postFrameFieldResponse struct{}
+
+// handlePatchFrameField handles PATCH /frame/field request.
+func (h *Handler) handlePatchFrameField(w http.ResponseWriter, r *http.Request) {
+	indexName := mux.Vars(r)["index"]
+	frameName := mux.Vars(r)["frame"]
+	fieldName := mux.Vars(r)["field"]
+
+	// Decode request.
+	var req patchFrameFieldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve frame & field by name.
+	f := h.Holder.Frame(indexName, frameName)
+	if f == nil {
+		http.Error(w, ErrFrameNotFound.Error(), http.StatusNotFound)
+		return
+	}
+	field := f.Field(fieldName)
+	if field == nil {
+		http.Error(w, ErrFieldNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Convert the new range into the field's units.
+	if len(req.Min) == 0 || len(req.Max) == 0 {
+		http.Error(w, ErrInvalidFieldRange.Error(), http.StatusBadRequest)
+		return
+	}
+	min, err := field.unmarshalJSONValue(req.Min)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	max, err := field.unmarshalJSONValue(req.Max)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Re-encode the field's data for the new range.
+	if err := f.RerangeField(fieldName, min, max); err == ErrInvalidFieldRange || err == ErrFieldValueTooLow || err == ErrFieldValueTooHigh {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send the rerange field message to all nodes.
+	err = h.Broadcaster.SendSync(
+		&internal.RerangeFieldMessage{
+			Index: indexName,
+			Frame: frameName,
+			Field: fieldName,
+			Min:   min,
+			Max:   max,
+		})
+	if err != nil {
+		h.logger().Printf("problem sending RerangeField message: %s", err)
+	}
+
+	// Encode response.
+	if err := json.NewEncoder(w).Encode(patchFrameFieldResponse{}); err != nil {
+		h.logger().Printf("response encoding error: %s", err)
+	}
 }
 
-type postFrameFieldResponse struct{}
+type patchFrameFieldRequest struct {
+	Min json.RawMessage `json:"min"`
+	Max json.RawMessage `json:"max"`
+}
+
+type patchFrameFieldResponse struct{}
 
 // handleDeleteFrameField handles DELETE /frame/field request.
 func (h *Handler) handleDeleteFrameField(w http.ResponseWriter, r *http.Request) {
@@ -839,6 +1041,17 @@ func (h *Handler) handleDeleteFrameField(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Send the delete field message to all nodes.
+	err := h.Broadcaster.SendSync(
+		&internal.DeleteFieldMessage{
+			Index: indexName,
+			Frame: frameName,
+			Field: fieldName,
+		})
+	if err != nil {
+		h.logger().Printf("problem sending DeleteField message: %s", err)
+	}
+
 	// Encode response.
 	if err := json.NewEncoder(w).Encode(deleteFrameFieldResponse{}); err != nil {
 		h.logger().Printf("response encoding error: %s", err)
@@ -950,6 +1163,100 @@ func (h *Handler) handleDeleteView(w http.ResponseWriter, r *http.Request) {
 
 type deleteViewResponse struct{}
 
+// handlePostAttrIndex handles POST /attr-index requests for column attributes
+// on an index or row attributes on a frame.
+func (h *Handler) handlePostAttrIndex(w http.ResponseWriter, r *http.Request) {
+	indexName := mux.Vars(r)["index"]
+	frameName := mux.Vars(r)["frame"]
+	attrName := mux.Vars(r)["attr"]
+
+	store, err := h.attrStore(indexName, frameName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Build the index.
+	if err := store.CreateIndex(attrName); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send the create attribute index message to all nodes.
+	err = h.Broadcaster.SendSync(
+		&internal.CreateAttrIndexMessage{
+			Index: indexName,
+			Frame: frameName,
+			Attr:  attrName,
+		})
+	if err != nil {
+		h.logger().Printf("problem sending CreateAttrIndex message: %s", err)
+	}
+
+	// Encode response.
+	if err := json.NewEncoder(w).Encode(postAttrIndexResponse{}); err != nil {
+		h.logger().Printf("response encoding error: %s", err)
+	}
+}
+
+type postAttrIndexResponse struct{}
+
+// handleDeleteAttrIndex handles DELETE /attr-index requests for column
+// attributes on an index or row attributes on a frame.
+func (h *Handler) handleDeleteAttrIndex(w http.ResponseWriter, r *http.Request) {
+	indexName := mux.Vars(r)["index"]
+	frameName := mux.Vars(r)["frame"]
+	attrName := mux.Vars(r)["attr"]
+
+	store, err := h.attrStore(indexName, frameName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Remove the index.
+	if err := store.DeleteIndex(attrName); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send the delete attribute index message to all nodes.
+	err = h.Broadcaster.SendSync(
+		&internal.DeleteAttrIndexMessage{
+			Index: indexName,
+			Frame: frameName,
+			Attr:  attrName,
+		})
+	if err != nil {
+		h.logger().Printf("problem sending DeleteAttrIndex message: %s", err)
+	}
+
+	// Encode response.
+	if err := json.NewEncoder(w).Encode(deleteAttrIndexResponse{}); err != nil {
+		h.logger().Printf("response encoding error: %s", err)
+	}
+}
+
+type deleteAttrIndexResponse struct{}
+
+// attrStore returns the row attribute store for a frame or, if frame is
+// blank, the column attribute store for an index.
+func (h *Handler) attrStore(index, frame string) (*AttrStore, error) {
+	if frame == "" {
+		idx := h.Holder.Index(index)
+		if idx == nil {
+			return nil, ErrIndexNotFound
+		}
+		return idx.ColumnAttrStore(), nil
+	}
+
+	f := h.Holder.Frame(index, frame)
+	if f == nil {
+		return nil, ErrFrameNotFound
+	}
+	return f.RowAttrStore(), nil
+}
+
 type getFrameViewsResponse struct {
 	Views []string `json:"views,omitempty"`
 }
@@ -1013,7 +1320,8 @@ type postFrameAttrDiffResponse struct {
 }
 
 // readColumnAttrSets returns a list of column attribute objects by id.
-func (h *Handler) readColumnAttrSets(index *Index, ids []uint64) ([]*ColumnAttrSet, error) {
+// If keys is non-empty then only those attributes are returned.
+func (h *Handler) readColumnAttrSets(index *Index, ids []uint64, keys []string) ([]*ColumnAttrSet, error) {
 	if index == nil {
 		return nil, nil
 	}
@@ -1024,7 +1332,9 @@ func (h *Handler) readColumnAttrSets(index *Index, ids []uint64) ([]*ColumnAttrS
 		attrs, err := index.ColumnAttrStore().Attrs(id)
 		if err != nil {
 			return nil, err
-		} else if len(attrs) == 0 {
+		}
+		attrs = filterAttrs(attrs, keys)
+		if len(attrs) == 0 {
 			continue
 		}
 
@@ -1035,6 +1345,22 @@ func (h *Handler) readColumnAttrSets(index *Index, ids []uint64) ([]*ColumnAttrS
 	return a, nil
 }
 
+// handlePostQueryExplain writes the plan for a query back to the client.
+func (h *Handler) handlePostQueryExplain(w http.ResponseWriter, r *http.Request, indexName string, q *pql.Query, req *QueryRequest, opt *ExecOptions) {
+	plans, err := h.Executor.Explain(r.Context(), indexName, q, req.Slices, opt)
+	resp := &QueryResponse{Err: err}
+	for _, plan := range plans {
+		resp.Results = append(resp.Results, plan)
+	}
+
+	if resp.Err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	if err := h.writeQueryResponse(w, r, resp); err != nil {
+		h.logger().Printf("write query response error: %s", err)
+	}
+}
+
 // readQueryRequest parses an query parameters from r.
 func (h *Handler) readQueryRequest(r *http.Request) (*QueryRequest, error) {
 	switch r.Header.Get("Content-Type") {
@@ -1085,12 +1411,64 @@ func (h *Handler) readURLQueryRequest(r *http.Request) (*QueryRequest, error) {
 		return nil, errors.New("invalid slice argument")
 	}
 
+	// Parse timeout, if specified.
+	var timeout time.Duration
+	if s := q.Get("timeout"); s != "" {
+		if timeout, err = time.ParseDuration(s); err != nil || timeout < 0 {
+			return nil, errors.New("invalid timeout argument")
+		}
+	}
+
+	// Parse attribute allow-list.
+	var attrKeys []string
+	for _, key := range strings.Split(q.Get("attrKeys"), ",") {
+		if key != "" {
+			attrKeys = append(attrKeys, key)
+		}
+	}
+
+	// Parse column attribute pagination, if specified.
+	var columnAttrsLimit, columnAttrsOffset uint64
+	if s := q.Get("columnAttrsLimit"); s != "" {
+		if columnAttrsLimit, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, errors.New("invalid columnAttrsLimit argument")
+		}
+	}
+	if s := q.Get("columnAttrsOffset"); s != "" {
+		if columnAttrsOffset, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, errors.New("invalid columnAttrsOffset argument")
+		}
+	}
+
+	// Parse bitmap pagination, if specified.
+	var limit, offset uint64
+	if s := q.Get("limit"); s != "" {
+		if limit, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, errors.New("invalid limit argument")
+		}
+	}
+	if s := q.Get("offset"); s != "" {
+		if offset, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, errors.New("invalid offset argument")
+		}
+	}
+
 	return &QueryRequest{
-		Query:        query,
-		Slices:       slices,
-		ColumnAttrs:  q.Get("columnAttrs") == "true",
-		ExcludeAttrs: q.Get("excludeAttrs") == "true",
-		ExcludeBits:  q.Get("excludeBits") == "true",
+		Query:             query,
+		Slices:            slices,
+		ColumnAttrs:       q.Get("columnAttrs") == "true",
+		ExcludeAttrs:      q.Get("excludeAttrs") == "true",
+		ExcludeBits:       q.Get("excludeBits") == "true",
+		Explain:           q.Get("explain") == "true",
+		Profile:           q.Get("profile") == "true",
+		Timeout:           timeout,
+		AttrKeys:          attrKeys,
+		ColumnAttrsLimit:  columnAttrsLimit,
+		ColumnAttrsOffset: columnAttrsOffset,
+		Limit:             limit,
+		Offset:            offset,
+		Cursor:            q.Get("cursor"),
+		BitmapFormat:      q.Get("bitmapFormat"),
 	}, nil
 }
 
@@ -1134,6 +1512,12 @@ func (h *Handler) writeJSONQueryResponse(w http.ResponseWriter, resp *QueryRespo
 
 // handlePostImport handles /import requests.
 func (h *Handler) handlePostImport(w http.ResponseWriter, r *http.Request) {
+	// Serialized bitmaps are imported as a single row.
+	if r.Header.Get("Content-Type") == "application/x-roaring" {
+		h.handlePostImportRoaring(w, r)
+		return
+	}
+
 	// Verify that request is only communicating over protobufs.
 	if r.Header.Get("Content-Type") != "application/x-protobuf" {
 		http.Error(w, "Unsupported media type", http.StatusUnsupportedMediaType)
@@ -1167,6 +1551,24 @@ func (h *Handler) handlePostImport(w http.ResponseWriter, r *http.Request) {
 		timestamps[i] = &t
 	}
 
+	// Keyed bits can belong to any slice so translate them to IDs and
+	// forward them to the owners of each slice.
+	if len(req.RowKeys) > 0 || len(req.ColumnKeys) > 0 {
+		if err := h.importKeys(r.Context(), &req); err != nil {
+			h.logger().Printf("import keys error: index=%s, frame=%s, err=%s", req.Index, req.Frame, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		buf, err := proto.Marshal(&internal.ImportResponse{})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("marshal import response: %s", err), http.StatusInternalServerError)
+			return
+		}
+		w.Write(buf)
+		return
+	}
+
 	// Validate that this handler owns the slice.
 	if !h.Cluster.OwnsFragment(h.URI.HostPort(), req.Index, req.Slice) {
 		mesg := fmt.Sprintf("host does not own slice %s-%s slice:%d", h.URI, req.Index, req.Slice)
@@ -1212,6 +1614,119 @@ func (h *Handler) handlePostImport(w http.ResponseWriter, r *http.Request) {
 	w.Write(buf)
 }
 
+// importKeys translates the row & column keys of an import request to IDs and
+// imports the bits to the nodes which own each slice.
+func (h *Handler) importKeys(ctx context.Context, req *internal.ImportRequest) error {
+	index := h.Holder.Index(req.Index)
+	if index == nil {
+		return ErrIndexNotFound
+	} else if index.Frame(req.Frame) == nil {
+		return ErrFrameNotFound
+	}
+
+	// Translate keys. Keys take precedence over IDs.
+	if len(req.RowKeys) > 0 {
+		ids, err := h.Translator.TranslateKeys(ctx, req.Index, req.Frame, req.RowKeys)
+		if err != nil {
+			return err
+		}
+		req.RowIDs = ids
+	}
+	if len(req.ColumnKeys) > 0 {
+		ids, err := h.Translator.TranslateKeys(ctx, req.Index, "", req.ColumnKeys)
+		if err != nil {
+			return err
+		}
+		req.ColumnIDs = ids
+	}
+	if len(req.RowIDs) != len(req.ColumnIDs) {
+		return errors.New("row and column count mismatch")
+	}
+
+	// Convert to bits and import by slice.
+	bits := make([]Bit, len(req.ColumnIDs))
+	for i := range bits {
+		bits[i] = Bit{RowID: req.RowIDs[i], ColumnID: req.ColumnIDs[i]}
+		if i < len(req.Timestamps) {
+			bits[i].Timestamp = req.Timestamps[i]
+		}
+	}
+
+	client := NewInternalHTTPClientFromURI(h.URI, h.ClientOptions)
+	for slice, bits := range Bits(bits).GroupBySlice() {
+		if err := client.Import(ctx, req.Index, req.Frame, slice, bits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handlePostImportRoaring imports a row from a bitmap in a portable roaring
+// format. The bits are forwarded to the owners of each slice.
+func (h *Handler) handlePostImportRoaring(w http.ResponseWriter, r *http.Request) {
+	// Parse query parameters.
+	q := r.URL.Query()
+	indexName, frameName := q.Get("index"), q.Get("frame")
+
+	rowID, err := strconv.ParseUint(q.Get("row"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid row", http.StatusBadRequest)
+		return
+	}
+
+	format := q.Get("format")
+	if format == "" {
+		format = BitmapFormatRoaring64
+	} else if !IsValidBitmapFormat(format) {
+		http.Error(w, ErrInvalidBitmapFormat.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Verify the frame exists before reading the bitmap.
+	index := h.Holder.Index(indexName)
+	if index == nil {
+		http.Error(w, ErrIndexNotFound.Error(), http.StatusNotFound)
+		return
+	} else if index.Frame(frameName) == nil {
+		http.Error(w, ErrFrameNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Read & decode the bitmap.
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	bm, err := decodeRoaring(body, format)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("decode bitmap: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// Convert to bits and import by slice.
+	bits := make([]Bit, 0, bm.Count())
+	bm.ForEach(func(columnID uint64) {
+		bits = append(bits, Bit{RowID: rowID, ColumnID: columnID})
+	})
+
+	client := NewInternalHTTPClientFromURI(h.URI, h.ClientOptions)
+	for slice, bits := range Bits(bits).GroupBySlice() {
+		if err := client.Import(r.Context(), indexName, frameName, slice, bits); err != nil {
+			h.logger().Printf("import roaring error: index=%s, frame=%s, slice=%d, err=%s", indexName, frameName, slice, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	buf, err := proto.Marshal(&internal.ImportResponse{})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("marshal import response: %s", err), http.StatusInternalServerError)
+		return
+	}
+	w.Write(buf)
+}
+
 // handlePostImportValue handles /import-value requests.
 func (h *Handler) handlePostImportValue(w http.ResponseWriter, r *http.Request) {
 	// Verify that request is only communicating over protobufs.
@@ -1261,8 +1776,18 @@ func (h *Handler) handlePostImportValue(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Convert values to the field's scaled units.
+	values := req.Values
+	if field := f.Field(req.Field); field != nil {
+		if values, err = field.ImportValues(req.Values, req.DecimalValues); err != nil {
+			h.logger().Printf("import error: index=%s, frame=%s, slice=%d, field=%s, err=%s", req.Index, req.Frame, req.Slice, req.Field, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
 	// Import into fragment.
-	err = f.ImportValue(req.Field, req.ColumnIDs, req.Values)
+	err = f.ImportValue(req.Field, req.ColumnIDs, values)
 	if err != nil {
 		h.logger().Printf("import error: index=%s, frame=%s, slice=%d, field=%s, bits=%d, err=%s", req.Index, req.Frame, req.Slice, req.Field, len(req.ColumnIDs), err)
 		return
@@ -1287,6 +1812,8 @@ func (h *Handler) handleGetExport(w http.ResponseWriter, r *http.Request) {
 	switch r.Header.Get("Accept") {
 	case "text/csv":
 		h.handleGetExportCSV(w, r)
+	case "application/x-roaring":
+		h.handleGetExportRoaring(w, r)
 	default:
 		http.Error(w, "Not acceptable", http.StatusNotAcceptable)
 	}
@@ -1319,6 +1846,16 @@ func (h *Handler) handleGetExportCSV(w http.ResponseWriter, r *http.Request) {
 	// Wrap writer with a CSV writer.
 	cw := csv.NewWriter(w)
 
+	// Field views are exported as column/value pairs.
+	if strings.HasPrefix(view, ViewFieldPrefix) {
+		if err := h.writeFieldValuesCSV(cw, index, frame, strings.TrimPrefix(view, ViewFieldPrefix), f); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		cw.Flush()
+		return
+	}
+
 	// Iterate over each bit.
 	if err := f.ForEachBit(func(rowID, columnID uint64) error {
 		return cw.Write([]string{
@@ -1334,6 +1871,88 @@ func (h *Handler) handleGetExportCSV(w http.ResponseWriter, r *http.Request) {
 	cw.Flush()
 }
 
+// handleGetExportRoaring writes the columns of a row within a slice in a
+// portable roaring format.
+func (h *Handler) handleGetExportRoaring(w http.ResponseWriter, r *http.Request) {
+	// Parse query parameters.
+	q := r.URL.Query()
+	index, frame, view := q.Get("index"), q.Get("frame"), q.Get("view")
+	if view == "" {
+		view = ViewStandard
+	}
+
+	slice, err := strconv.ParseUint(q.Get("slice"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid slice", http.StatusBadRequest)
+		return
+	}
+
+	rowID, err := strconv.ParseUint(q.Get("row"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid row", http.StatusBadRequest)
+		return
+	}
+
+	format := q.Get("format")
+	if format == "" {
+		format = BitmapFormatRoaring64
+	} else if !IsValidBitmapFormat(format) {
+		http.Error(w, ErrInvalidBitmapFormat.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate that this handler owns the slice.
+	if !h.Cluster.OwnsFragment(h.URI.HostPort(), index, slice) {
+		mesg := fmt.Sprintf("host does not own slice %s-%s slice:%d", h.URI, index, slice)
+		http.Error(w, mesg, http.StatusPreconditionFailed)
+		return
+	}
+
+	// Missing fragments are exported as empty bitmaps.
+	bm := NewBitmap()
+	if f := h.Holder.Fragment(index, frame, view, slice); f != nil {
+		bm = f.Row(rowID)
+	}
+
+	buf, err := bm.MarshalRoaring(format)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-roaring")
+	w.Write(buf)
+}
+
+// writeFieldValuesCSV writes the value of each column in a field fragment.
+// Decimal values are written with the field's scale.
+func (h *Handler) writeFieldValuesCSV(cw *csv.Writer, index, frame, name string, frag *Fragment) error {
+	field := h.Holder.Frame(index, frame).Field(name)
+	if field == nil {
+		return ErrFieldNotFound
+	}
+
+	bm, err := frag.FieldNotNull(field.BitDepth())
+	if err != nil {
+		return err
+	}
+	for _, columnID := range bm.Bits() {
+		v, exists, err := frag.FieldValue(columnID, field.BitDepth())
+		if err != nil {
+			return err
+		} else if !exists {
+			continue
+		}
+
+		if err := cw.Write([]string{
+			strconv.FormatUint(columnID, 10),
+			field.FormatValue(int64(v) + field.Min),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // handleGetFragmentNodes handles /fragment/nodes requests.
 func (h *Handler) handleGetFragmentNodes(w http.ResponseWriter, r *http.Request) {
 	q := r.URL.Query()
@@ -1416,6 +2035,88 @@ func (h *Handler) handlePostFragmentData(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// handlePostTranslateKeys handles POST /translate/keys requests.
+func (h *Handler) handlePostTranslateKeys(w http.ResponseWriter, r *http.Request) {
+	// Read request object.
+	var req internal.TranslateKeysRequest
+	if body, err := ioutil.ReadAll(r.Body); err != nil {
+		http.Error(w, "read body error", http.StatusBadRequest)
+		return
+	} else if err := proto.Unmarshal(body, &req); err != nil {
+		http.Error(w, "unmarshal body error", http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve translation store from holder.
+	store, err := h.Holder.TranslateStore(req.Index, req.Frame)
+	if err != nil {
+		http.Error(w, err.Error(), translateStoreErrorStatus(err))
+		return
+	}
+
+	translate := store.TranslateKeys
+	if req.LookupOnly {
+		translate = store.LookupKeys
+	}
+	ids, err := translate(req.Keys)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeTranslateResponse(w, &internal.TranslateKeysResponse{IDs: ids})
+}
+
+// handlePostTranslateIDs handles POST /translate/ids requests.
+func (h *Handler) handlePostTranslateIDs(w http.ResponseWriter, r *http.Request) {
+	// Read request object.
+	var req internal.TranslateIDsRequest
+	if body, err := ioutil.ReadAll(r.Body); err != nil {
+		http.Error(w, "read body error", http.StatusBadRequest)
+		return
+	} else if err := proto.Unmarshal(body, &req); err != nil {
+		http.Error(w, "unmarshal body error", http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve translation store from holder.
+	store, err := h.Holder.TranslateStore(req.Index, req.Frame)
+	if err != nil {
+		http.Error(w, err.Error(), translateStoreErrorStatus(err))
+		return
+	}
+
+	keys, err := store.TranslateIDs(req.IDs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeTranslateResponse(w, &internal.TranslateIDsResponse{Keys: keys})
+}
+
+// writeTranslateResponse writes a protobuf encoded translation response.
+func (h *Handler) writeTranslateResponse(w http.ResponseWriter, resp proto.Message) {
+	buf, err := proto.Marshal(resp)
+	if err != nil {
+		h.logger().Printf("translate response encoding error: %s", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Content-Length", strconv.Itoa(len(buf)))
+	w.Write(buf)
+}
+
+// translateStoreErrorStatus returns the HTTP status for a translate store lookup error.
+func translateStoreErrorStatus(err error) int {
+	switch err {
+	case ErrIndexNotFound, ErrFrameNotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusBadRequest
+	}
+}
+
 // handleGetFragmentData handles GET /fragment/block/data requests.
 func (h *Handler) handleGetFragmentBlockData(w http.ResponseWriter, r *http.Request) {
 	// Read request object.
@@ -1630,6 +2331,13 @@ type QueryRequest struct {
 	// Return column attributes, if true.
 	ColumnAttrs bool
 
+	// Return timings for each step of query execution, if true.
+	Profile bool
+
+	// Maximum time to spend executing the query.
+	// If zero, the query runs until completion or the client disconnects.
+	Timeout time.Duration
+
 	// Do not return row attributes, if true.
 	ExcludeAttrs bool
 
@@ -1639,6 +2347,34 @@ type QueryRequest struct {
 	// If true, indicates that query is part of a larger distributed query.
 	// If false, this request is on the originating node.
 	Remote bool
+
+	// Return a plan for each call instead of executing the query, if true.
+	Explain bool
+
+	// Attribute keys to return for row & column attributes.
+	// If empty, all attributes are returned.
+	AttrKeys []string
+
+	// Maximum number of columns to return attributes for, if non-zero.
+	ColumnAttrsLimit uint64
+
+	// Number of result columns to skip before returning column attributes.
+	ColumnAttrsOffset uint64
+
+	// Maximum number of bits to return for each bitmap result, if non-zero.
+	Limit uint64
+
+	// Number of bits to skip in each bitmap result.
+	Offset uint64
+
+	// Cursor returned by a previous page of bitmap results.
+	Cursor string
+
+	// Portable roaring format to return bitmap results in, if set.
+	BitmapFormat string
+
+	// Query that a remote request is part of, if the query has bindings.
+	QueryID string
 }
 
 func decodeQueryRequest(pb *internal.QueryRequest) *QueryRequest {
@@ -1649,6 +2385,18 @@ func decodeQueryRequest(pb *internal.QueryRequest) *QueryRequest {
 		Remote:       pb.Remote,
 		ExcludeAttrs: pb.ExcludeAttrs,
 		ExcludeBits:  pb.ExcludeBits,
+		Explain:      pb.Explain,
+		Profile:      pb.Profile,
+		Timeout:      time.Duration(pb.Timeout),
+
+		AttrKeys:          pb.AttrKeys,
+		ColumnAttrsLimit:  pb.ColumnAttrsLimit,
+		ColumnAttrsOffset: pb.ColumnAttrsOffset,
+		Limit:             pb.Limit,
+		Offset:            pb.Offset,
+		Cursor:            pb.Cursor,
+		BitmapFormat:      pb.BitmapFormat,
+		QueryID:           pb.QueryID,
 	}
 
 	return req
@@ -1663,6 +2411,9 @@ type QueryResponse struct {
 	// Set of column attribute objects matching IDs returned in Result.
 	ColumnAttrSets []*ColumnAttrSet
 
+	// Timings for each step of query execution, if requested.
+	Profile *Profile
+
 	// Error during parsing or execution.
 	Err error
 }
@@ -1672,10 +2423,12 @@ func (resp *QueryResponse) MarshalJSON() ([]byte, error) {
 	var output struct {
 		Results        []interface{}    `json:"results,omitempty"`
 		ColumnAttrSets []*ColumnAttrSet `json:"columnAttrs,omitempty"`
+		Profile        *Profile         `json:"profile,omitempty"`
 		Err            string           `json:"error,omitempty"`
 	}
 	output.Results = resp.Results
 	output.ColumnAttrSets = resp.ColumnAttrSets
+	output.Profile = resp.Profile
 
 	if resp.Err != nil {
 		output.Err = resp.Err.Error()
@@ -1687,6 +2440,7 @@ func encodeQueryResponse(resp *QueryResponse) *internal.QueryResponse {
 	pb := &internal.QueryResponse{
 		Results:        make([]*internal.QueryResult, len(resp.Results)),
 		ColumnAttrSets: encodeColumnAttrSets(resp.ColumnAttrSets),
+		Profile:        encodeProfile(resp.Profile),
 	}
 
 	for i := range resp.Results {
@@ -1699,6 +2453,12 @@ func encodeQueryResponse(resp *QueryResponse) *internal.QueryResponse {
 			pb.Results[i].Pairs = encodePairs(result)
 		case SumCount:
 			pb.Results[i].SumCount = encodeSumCount(result)
+		case ValCount:
+			pb.Results[i].ValCount = encodeValCount(result)
+		case []GroupCount:
+			pb.Results[i].GroupCounts = encodeGroupCounts(result)
+		case *CallPlan:
+			pb.Results[i].Plan = encodeCallPlan(result)
 		case uint64:
 			pb.Results[i].N = result
 		case bool:
@@ -1732,6 +2492,19 @@ func parseUint64Slice(s string) ([]uint64, error) {
 	return a, nil
 }
 
+// pageUint64s returns the values of a after skipping offset values.
+// If limit is non-zero then at most limit values are returned.
+func pageUint64s(a []uint64, offset, limit uint64) []uint64 {
+	if offset >= uint64(len(a)) {
+		return nil
+	}
+	a = a[offset:]
+	if limit > 0 && limit < uint64(len(a)) {
+		a = a[:limit]
+	}
+	return a
+}
+
 // errorString returns the string representation of err.
 func errorString(err error) string {
 	if err == nil {
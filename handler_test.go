@@ -31,9 +31,143 @@ import (
 	"github.com/pilosa/pilosa"
 	"github.com/pilosa/pilosa/internal"
 	"github.com/pilosa/pilosa/pql"
+	"github.com/pilosa/pilosa/roaring"
 	"github.com/pilosa/pilosa/test"
 )
 
+// Ensure the handler can import bits by row & column keys.
+func TestHandler_Import_Keys(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{Keys: true})
+	f, err := idx.CreateFrame("f", pilosa.FrameOptions{Keys: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := test.NewServer()
+	defer s.Close()
+	s.Handler.URI = s.HostURI()
+	s.Handler.Holder = hldr.Holder
+
+	tr := pilosa.NewTranslator(nil)
+	tr.Holder = hldr.Holder
+	tr.Host = s.Host()
+	tr.Cluster = s.Handler.Cluster
+	s.Handler.Translator = tr
+
+	// Encode request body.
+	buf, err := proto.Marshal(&internal.ImportRequest{
+		Index:      "i",
+		Frame:      "f",
+		RowKeys:    []string{"chrome", "chrome", "firefox"},
+		ColumnKeys: []string{"user-a", "user-b", "user-b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := test.MustNewHTTPRequest("POST", s.URL+"/import", bytes.NewReader(buf))
+	r.Header.Set("Content-Type", "application/x-protobuf")
+	r.Header.Set("Accept", "application/x-protobuf")
+	resp, err := http.DefaultClient.Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	// Verify keys were translated and bits were set.
+	if ids, err := f.TranslateStore().TranslateKeys([]string{"chrome", "firefox"}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1, 2}) {
+		t.Fatalf("unexpected row ids: %v", ids)
+	}
+	frag := hldr.Fragment("i", "f", pilosa.ViewStandard, 0)
+	if frag == nil {
+		t.Fatal("expected fragment")
+	} else if bits := frag.Row(1).Bits(); !reflect.DeepEqual(bits, []uint64{1, 2}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	} else if bits := frag.Row(2).Bits(); !reflect.DeepEqual(bits, []uint64{2}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	}
+}
+
+// Ensure the handler can import & export a row as a portable roaring bitmap.
+func TestHandler_Import_Roaring(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	if _, err := idx.CreateFrame("f", pilosa.FrameOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := test.NewServer()
+	defer s.Close()
+	s.Handler.URI = s.HostURI()
+	s.Handler.Holder = hldr.Holder
+
+	// Encode a bitmap spanning two slices.
+	var buf bytes.Buffer
+	if _, err := roaring.NewBitmap(1, pilosa.SliceWidth+2).WritePortable64To(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	r := test.MustNewHTTPRequest("POST", s.URL+"/import?index=i&frame=f&row=3", &buf)
+	r.Header.Set("Content-Type", "application/x-roaring")
+	resp, err := http.DefaultClient.Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	// Verify bits were set in each slice.
+	if bits := hldr.Fragment("i", "f", pilosa.ViewStandard, 0).Row(3).Bits(); !reflect.DeepEqual(bits, []uint64{1}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	} else if bits := hldr.Fragment("i", "f", pilosa.ViewStandard, 1).Row(3).Bits(); !reflect.DeepEqual(bits, []uint64{pilosa.SliceWidth + 2}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	}
+
+	// Export the row from the second slice in the 32-bit format.
+	r = test.MustNewHTTPRequest("GET", s.URL+"/export?index=i&frame=f&slice=1&row=3&format=roaring32", nil)
+	r.Header.Set("Accept", "application/x-roaring")
+	resp, err = http.DefaultClient.Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	} else if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	bm := roaring.NewBitmap()
+	if err := bm.UnmarshalPortable32(body); err != nil {
+		t.Fatal(err)
+	} else if bits := bm.Slice(); !reflect.DeepEqual(bits, []uint64{pilosa.SliceWidth + 2}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	}
+
+	// Invalid formats are rejected.
+	r = test.MustNewHTTPRequest("POST", s.URL+"/import?index=i&frame=f&row=3&format=bad", bytes.NewReader(nil))
+	r.Header.Set("Content-Type", "application/x-roaring")
+	resp, err = http.DefaultClient.Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("unexpected status code: %d", resp.StatusCode)
+	}
+}
+
 func TestHandlerPanics(t *testing.T) {
 	h := test.NewHandler()
 	buf := &bytes.Buffer{}
@@ -245,6 +379,110 @@ func TestHandler_Query_Args_URL(t *testing.T) {
 	}
 }
 
+// Ensure the handler can return a query plan instead of results.
+func TestHandler_Query_Explain(t *testing.T) {
+	h := test.NewHandler()
+	h.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		t.Fatal("unexpected execution")
+		return nil, nil
+	}
+	h.Executor.ExplainFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]*pilosa.CallPlan, error) {
+		if query.String() != `Bitmap(rowID=100)` {
+			t.Fatalf("unexpected query: %s", query.String())
+		}
+		return []*pilosa.CallPlan{{
+			Call:  query.String(),
+			Views: []*pilosa.ViewPlan{{Call: query.String(), Frame: "general", Views: []string{"standard"}}},
+			Nodes: []*pilosa.NodePlan{{Host: "host0", Slices: []uint64{0}}},
+		}}, nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?explain=true", strings.NewReader("Bitmap(rowID=100)")))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code: %d %s", w.Code, w.Body.String())
+	} else if body := w.Body.String(); body != `{"results":[{"call":"Bitmap(rowID=100)","views":[{"call":"Bitmap(rowID=100)","frame":"general","views":["standard"]}],"nodes":[{"host":"host0","slices":[0]}]}]}`+"\n" {
+		t.Fatalf("unexpected body: %s", body)
+	}
+}
+
+// Ensure the handler can return a profile of the query.
+func TestHandler_Query_Profile(t *testing.T) {
+	h := test.NewHandler()
+	h.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		if pilosa.ProfileFromContext(ctx) == nil {
+			t.Fatal("expected profile")
+		}
+		return []interface{}{uint64(100)}, nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?profile=true", strings.NewReader("Count(Bitmap(rowID=100))")))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code: %d %s", w.Code, w.Body.String())
+	}
+
+	var resp struct {
+		Results []uint64        `json:"results"`
+		Profile *pilosa.Profile `json:"profile"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(resp.Results, []uint64{100}) {
+		t.Fatalf("unexpected results: %v", resp.Results)
+	} else if resp.Profile == nil || resp.Profile.Name != "query" {
+		t.Fatalf("unexpected profile: %s", w.Body.String())
+	} else if len(resp.Profile.Children) != 1 || resp.Profile.Children[0].Name != "parse" {
+		t.Fatalf("unexpected profile children: %s", w.Body.String())
+	}
+}
+
+// Ensure the handler applies the query timeout to the execution context.
+func TestHandler_Query_Timeout(t *testing.T) {
+	h := test.NewHandler()
+	h.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Fatal("expected deadline")
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	t.Run("Exceeded", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?timeout=10ms", strings.NewReader("Count(Bitmap(rowID=100))")))
+		if w.Code != http.StatusGatewayTimeout {
+			t.Fatalf("unexpected status code: %d %s", w.Code, w.Body.String())
+		} else if body := w.Body.String(); body != `{"error":"context deadline exceeded"}`+"\n" {
+			t.Fatalf("unexpected body: %q", body)
+		}
+	})
+
+	t.Run("Wrapped", func(t *testing.T) {
+		h := test.NewHandler()
+		h.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+			<-ctx.Done()
+			return nil, errors.New("remote: request canceled")
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?timeout=10ms", strings.NewReader("Count(Bitmap(rowID=100))")))
+		if w.Code != http.StatusGatewayTimeout {
+			t.Fatalf("unexpected status code: %d %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?timeout=xyz", strings.NewReader("Count(Bitmap(rowID=100))")))
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("unexpected status code: %d %s", w.Code, w.Body.String())
+		} else if body := w.Body.String(); body != `{"error":"invalid timeout argument"}`+"\n" {
+			t.Fatalf("unexpected body: %q", body)
+		}
+	})
+}
+
 // Ensure the handler can accept arguments via protobufs.
 func TestHandler_Query_Args_Protobuf(t *testing.T) {
 	hldr := test.MustOpenHolder()
@@ -417,6 +655,130 @@ func TestHandler_Query_Bitmap_ColumnAttrs_JSON(t *testing.T) {
 	}
 }
 
+// Ensure the handler can limit the column attributes returned with a bitmap.
+func TestHandler_Query_Bitmap_ColumnAttrs_Projection(t *testing.T) {
+	hldr := test.NewHolder()
+	defer hldr.Close()
+
+	// Create index and set column attributes.
+	index, err := hldr.CreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, attrs := range map[uint64]map[string]interface{}{
+		1:  {"x": "a", "y": 1},
+		3:  {"x": "b", "y": 2},
+		66: {"y": 3},
+		70: {"x": "c", "z": true},
+	} {
+		if err := index.ColumnAttrStore().SetAttrs(id, attrs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h := test.NewHandler()
+	h.Holder = hldr.Holder
+	h.Cluster = test.NewCluster(1)
+	h.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		if !reflect.DeepEqual(opt.AttrKeys, []string{"x", "z"}) {
+			t.Fatalf("unexpected attr keys: %v", opt.AttrKeys)
+		}
+		return []interface{}{pilosa.NewBitmap(1, 3, 66, 70)}, nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?columnAttrs=true&attrKeys=x,z&columnAttrsOffset=1&columnAttrsLimit=3", strings.NewReader("Bitmap(id=100)")))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	} else if body := w.Body.String(); body != `{"results":[{"attrs":{},"bits":[1,3,66,70]}],"columnAttrs":[{"id":3,"attrs":{"x":"b"}},{"id":70,"attrs":{"x":"c","z":true}}]}`+"\n" {
+		t.Fatalf("unexpected body: %s", body)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?columnAttrs=true&columnAttrsLimit=x", strings.NewReader("Bitmap(id=100)")))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	}
+}
+
+// Ensure the handler passes pagination arguments to the executor.
+func TestHandler_Query_Bitmap_Pagination(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+
+	h := test.NewHandler()
+	h.Holder = hldr.Holder
+	h.Cluster = test.NewCluster(1)
+	h.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		if opt.Cursor == "bad" {
+			return nil, pilosa.ErrInvalidCursor
+		} else if opt.Limit != 2 || opt.Offset != 5 || opt.Cursor != "AAAAAAAAAAM" {
+			t.Fatalf("unexpected options: %+v", opt)
+		}
+		bm := pilosa.NewBitmap(10, 11)
+		bm.Cursor = "AAAAAAAAAAw"
+		return []interface{}{bm}, nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?limit=2&offset=5&cursor=AAAAAAAAAAM", strings.NewReader("Bitmap(id=100)")))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	} else if body := w.Body.String(); body != `{"results":[{"attrs":{},"bits":[10,11],"cursor":"AAAAAAAAAAw"}]}`+"\n" {
+		t.Fatalf("unexpected body: %s", body)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?cursor=bad", strings.NewReader("Bitmap(id=100)")))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	}
+}
+
+// Ensure the handler can return bitmap results in a portable roaring format.
+func TestHandler_Query_Bitmap_Roaring(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+
+	h := test.NewHandler()
+	h.Holder = hldr.Holder
+	h.Cluster = test.NewCluster(1)
+	h.Executor.ExecuteFn = func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error) {
+		return []interface{}{pilosa.NewBitmap(1, pilosa.SliceWidth+1)}, nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?bitmapFormat=roaring64", strings.NewReader("Bitmap(id=100)")))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	}
+
+	var resp struct {
+		Results []struct {
+			Bits    []uint64 `json:"bits"`
+			Roaring []byte   `json:"roaring"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	} else if len(resp.Results) != 1 || len(resp.Results[0].Bits) != 0 {
+		t.Fatalf("unexpected results: %s", w.Body.String())
+	}
+
+	bm := roaring.NewBitmap()
+	if err := bm.UnmarshalPortable64(resp.Results[0].Roaring); err != nil {
+		t.Fatal(err)
+	} else if bits := bm.Slice(); !reflect.DeepEqual(bits, []uint64{1, pilosa.SliceWidth + 1}) {
+		t.Fatalf("unexpected bits: %v", bits)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i/query?bitmapFormat=bad", strings.NewReader("Bitmap(id=100)")))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	}
+}
+
 // Ensure the handler can execute a query that returns a bitmap as protobuf.
 func TestHandler_Query_Bitmap_Protobuf(t *testing.T) {
 	hldr := test.MustOpenHolder()
@@ -964,6 +1326,67 @@ func TestHandler_Frame_AddField(t *testing.T) {
 	})
 }
 
+// Ensure the handler can create & delete attribute indexes.
+func TestHandler_AttrIndex(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+
+	s := test.NewServer()
+	s.Handler.Holder = hldr.Holder
+	defer s.Close()
+
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	f, err := idx.CreateFrameIfNotExists("f", pilosa.FrameOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		method string
+		path   string
+		status int
+	}{
+		{method: "POST", path: "/index/i/attr-index/country", status: http.StatusOK},
+		{method: "POST", path: "/index/i/frame/f/attr-index/owner", status: http.StatusOK},
+		{method: "POST", path: "/index/x/attr-index/country", status: http.StatusNotFound},
+		{method: "POST", path: "/index/i/frame/x/attr-index/owner", status: http.StatusNotFound},
+	} {
+		req, err := http.NewRequest(tt.method, s.URL+tt.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		} else if err := resp.Body.Close(); err != nil {
+			t.Fatal(err)
+		} else if resp.StatusCode != tt.status {
+			t.Fatalf("%s %s: unexpected status code: %d", tt.method, tt.path, resp.StatusCode)
+		}
+	}
+
+	if keys := idx.ColumnAttrStore().IndexedAttrs(); !reflect.DeepEqual(keys, []string{"country"}) {
+		t.Fatalf("unexpected column indexes: %v", keys)
+	} else if keys := f.RowAttrStore().IndexedAttrs(); !reflect.DeepEqual(keys, []string{"owner"}) {
+		t.Fatalf("unexpected row indexes: %v", keys)
+	}
+
+	req, err := http.NewRequest("DELETE", s.URL+"/index/i/attr-index/country", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	} else if err := resp.Body.Close(); err != nil {
+		t.Fatal(err)
+	} else if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: %d", resp.StatusCode)
+	} else if keys := idx.ColumnAttrStore().IndexedAttrs(); len(keys) != 0 {
+		t.Fatalf("unexpected column indexes: %v", keys)
+	}
+}
+
 // Ensure the handler can delete existing fields.
 func TestHandler_Frame_DeleteField(t *testing.T) {
 	hldr := test.MustOpenHolder()
@@ -1030,6 +1453,69 @@ func TestHandler_Frame_DeleteField(t *testing.T) {
 	})
 }
 
+func TestHandler_Frame_RerangeField(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+
+	s := test.NewServer()
+	s.Handler.Holder = hldr.Holder
+	defer s.Close()
+
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+	f, err := idx.CreateFrameIfNotExists("f", pilosa.FrameOptions{RangeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	} else if err := f.CreateField(&pilosa.Field{Name: "x", Type: pilosa.FieldTypeDecimal, Scale: 1, Min: 0, Max: 1000}); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetFieldValue(1, "x", 525); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		req, err := http.NewRequest("PATCH", s.URL+"/index/i/frame/f/field/x", strings.NewReader(`{"min": -20.5, "max": 5000}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		} else if err := resp.Body.Close(); err != nil {
+			t.Fatal(err)
+		} else if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: %d", resp.StatusCode)
+		}
+
+		if field := f.Field("x"); field.Min != -205 || field.Max != 50000 {
+			t.Fatalf("unexpected field: %#v", field)
+		} else if v, exists, err := f.FieldValue(1, "x"); err != nil {
+			t.Fatal(err)
+		} else if !exists || v != 525 {
+			t.Fatalf("unexpected value: %d", v)
+		}
+	})
+
+	t.Run("ErrFieldValueTooHigh", func(t *testing.T) {
+		req, err := http.NewRequest("PATCH", s.URL+"/index/i/frame/f/field/x", strings.NewReader(`{"min": 0, "max": 50}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		} else if body, err := ioutil.ReadAll(resp.Body); err != nil {
+			t.Fatal(err)
+		} else if strings.TrimSpace(string(body)) != `field value too high` {
+			t.Fatalf("unexpected body: %q", body)
+		} else if err := resp.Body.Close(); err != nil {
+			t.Fatal(err)
+		} else if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("unexpected status code: %d", resp.StatusCode)
+		}
+	})
+}
+
 func TestHandler_Frame_GetFields(t *testing.T) {
 	hldr := test.MustOpenHolder()
 	defer hldr.Close()
@@ -15,6 +15,7 @@
 package pilosa
 
 import (
+	"container/heap"
 	"context"
 	"errors"
 	"fmt"
@@ -32,10 +33,32 @@ const (
 	// DefaultCacheFlushInterval is the default value for Fragment.CacheFlushInterval.
 	DefaultCacheFlushInterval = 1 * time.Minute
 
+	// DefaultFsyncInterval is the default value for Holder.FsyncInterval.
+	DefaultFsyncInterval = 1 * time.Second
+
+	// DefaultSnapshotWorkerN is the default value for Holder.SnapshotWorkerN.
+	DefaultSnapshotWorkerN = 2
+
 	// FileLimit is the maximum open file limit (ulimit -n) to automatically set.
 	FileLimit = 262144 // (512^2)
 )
 
+// Fsync policies for the fragment ops log.
+const (
+	// FsyncNone leaves flushing the ops log to the operating system.
+	FsyncNone = "none"
+
+	// FsyncInterval periodically syncs fragments which have been written to.
+	FsyncInterval = "interval"
+
+	// FsyncRequest syncs fragments which have been written to before a
+	// write request returns.
+	FsyncRequest = "request"
+)
+
+// FsyncPolicies set of fsync policies.
+var FsyncPolicies = []string{FsyncNone, FsyncInterval, FsyncRequest}
+
 // Holder represents a container for indexes.
 type Holder struct {
 	mu sync.RWMutex
@@ -57,6 +80,16 @@ type Holder struct {
 	// The interval at which the cached row ids are persisted to disk.
 	CacheFlushInterval time.Duration
 
+	// Durability policy for fragment ops and, for the interval policy,
+	// how often written fragments are synced.
+	FsyncPolicy   string
+	FsyncInterval time.Duration
+	syncer        *fragmentSyncer
+
+	// Number of fragments which can be snapshotted in the background at once.
+	SnapshotWorkerN int
+	snapshotQueue   *snapshotQueue
+
 	LogOutput io.Writer
 }
 
@@ -71,12 +104,25 @@ func NewHolder() *Holder {
 
 		CacheFlushInterval: DefaultCacheFlushInterval,
 
+		FsyncPolicy:   FsyncNone,
+		FsyncInterval: DefaultFsyncInterval,
+
+		SnapshotWorkerN: DefaultSnapshotWorkerN,
+
 		LogOutput: os.Stderr,
 	}
 }
 
 // Open initializes the root data directory for the holder.
 func (h *Holder) Open() error {
+	if !StringInSlice(h.FsyncPolicy, FsyncPolicies) {
+		return ErrInvalidFsyncPolicy
+	} else if h.FsyncPolicy == FsyncInterval && h.FsyncInterval <= 0 {
+		return ErrInvalidFsyncInterval
+	}
+	h.syncer = newFragmentSyncer(h.FsyncPolicy)
+	h.snapshotQueue = newSnapshotQueue(h.SnapshotWorkerN, h.logger())
+
 	h.setFileLimit()
 
 	if err := os.MkdirAll(h.Path, 0777); err != nil {
@@ -123,6 +169,12 @@ func (h *Holder) Open() error {
 	h.wg.Add(1)
 	go func() { defer h.wg.Done(); h.monitorCacheFlush() }()
 
+	// Periodically sync fragment ops.
+	if h.FsyncPolicy == FsyncInterval {
+		h.wg.Add(1)
+		go func() { defer h.wg.Done(); h.monitorFsync() }()
+	}
+
 	h.Stats.Open()
 	return nil
 }
@@ -135,6 +187,11 @@ func (h *Holder) Close() error {
 	close(h.closing)
 	h.wg.Wait()
 
+	// Wait for in-progress snapshots before closing fragments.
+	if h.snapshotQueue != nil {
+		h.snapshotQueue.close()
+	}
+
 	for _, index := range h.indexes {
 		if err := index.Close(); err != nil {
 			return err
@@ -257,6 +314,9 @@ func (h *Holder) createIndex(name string, opt IndexOptions) (*Index, error) {
 	// Update options.
 	index.SetColumnLabel(opt.ColumnLabel)
 	index.SetTimeQuantum(opt.TimeQuantum)
+	if err := index.SetKeys(opt.Keys); err != nil {
+		return nil, err
+	}
 
 	h.indexes[index.Name()] = index
 
@@ -271,6 +331,8 @@ func (h *Holder) newIndex(path, name string) (*Index, error) {
 	index.LogOutput = h.LogOutput
 	index.Stats = h.Stats.WithTags(fmt.Sprintf("index:%s", index.Name()))
 	index.broadcaster = h.Broadcaster
+	index.syncer = h.syncer
+	index.snapshotQueue = h.snapshotQueue
 	return index, nil
 }
 
@@ -328,6 +390,30 @@ func (h *Holder) Fragment(index, frame, view string, slice uint64) *Fragment {
 	return v.Fragment(slice)
 }
 
+// TranslateStore returns the key translation store for the columns of an
+// index or, if frame is specified, for the rows of a frame.
+func (h *Holder) TranslateStore(index, frame string) (*TranslateStore, error) {
+	idx := h.Index(index)
+	if idx == nil {
+		return nil, ErrIndexNotFound
+	}
+
+	if frame == "" {
+		if !idx.Keys() {
+			return nil, ErrIndexKeysNotEnabled
+		}
+		return idx.TranslateStore(), nil
+	}
+
+	f := idx.Frame(frame)
+	if f == nil {
+		return nil, ErrFrameNotFound
+	} else if !f.Keys() {
+		return nil, ErrFrameKeysNotEnabled
+	}
+	return f.TranslateStore(), nil
+}
+
 // monitorCacheFlush periodically flushes all fragment caches sequentially.
 // This is run in a goroutine.
 func (h *Holder) monitorCacheFlush() {
@@ -364,6 +450,213 @@ func (h *Holder) flushCaches() {
 	}
 }
 
+// Sync flushes ops written to fragments since their last sync to disk.
+func (h *Holder) Sync() error {
+	if h.syncer == nil {
+		return nil
+	}
+	return h.syncer.sync()
+}
+
+// monitorFsync periodically syncs written fragments.
+// This is run in a goroutine.
+func (h *Holder) monitorFsync() {
+	ticker := time.NewTicker(h.FsyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.closing:
+			return
+		case <-ticker.C:
+			if err := h.Sync(); err != nil {
+				h.logger().Printf("error syncing fragments: err=%s", err)
+			}
+		}
+	}
+}
+
+// fragmentSyncer tracks fragments with ops that have not been synced to disk.
+type fragmentSyncer struct {
+	// Serializes syncs so a sync doesn't return while ops it is responsible
+	// for are still being flushed by a concurrent sync.
+	syncMu sync.Mutex
+
+	mu        sync.Mutex
+	policy    string
+	fragments map[*Fragment]struct{}
+}
+
+func newFragmentSyncer(policy string) *fragmentSyncer {
+	return &fragmentSyncer{
+		policy:    policy,
+		fragments: make(map[*Fragment]struct{}),
+	}
+}
+
+// add registers a fragment with unsynced ops.
+func (s *fragmentSyncer) add(f *Fragment) {
+	s.mu.Lock()
+	s.fragments[f] = struct{}{}
+	s.mu.Unlock()
+}
+
+// sync flushes all registered fragments. Fragments which fail to sync are
+// kept so they are retried on the next sync.
+func (s *fragmentSyncer) sync() error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	s.mu.Lock()
+	fragments := s.fragments
+	s.fragments = make(map[*Fragment]struct{})
+	s.mu.Unlock()
+
+	var syncErr error
+	for f := range fragments {
+		if err := f.Sync(); err != nil {
+			s.add(f)
+			if syncErr == nil {
+				syncErr = fmt.Errorf("sync fragment: path=%s, err=%s", f.Path(), err)
+			}
+		}
+	}
+	return syncErr
+}
+
+// snapshotQueue snapshots fragments in the background using a fixed number
+// of workers. Fragments with the most ops in their log are snapshotted first.
+type snapshotQueue struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	items   snapshotQueueItems
+	queued  map[*Fragment]*snapshotQueueItem
+	running map[*Fragment]struct{}
+	closed  bool
+
+	wg     sync.WaitGroup
+	logger *log.Logger
+}
+
+// newSnapshotQueue returns a new queue and starts its workers.
+func newSnapshotQueue(workerN int, logger *log.Logger) *snapshotQueue {
+	if workerN < 1 {
+		workerN = 1
+	}
+
+	q := &snapshotQueue{
+		queued:  make(map[*Fragment]*snapshotQueueItem),
+		running: make(map[*Fragment]struct{}),
+		logger:  logger,
+	}
+	q.cond = sync.NewCond(&q.mu)
+
+	q.wg.Add(workerN)
+	for i := 0; i < workerN; i++ {
+		go func() { defer q.wg.Done(); q.run() }()
+	}
+	return q
+}
+
+// close stops the workers once their current snapshots are complete.
+// Fragments which are still queued keep their ops log.
+func (q *snapshotQueue) close() {
+	q.mu.Lock()
+	q.closed = true
+	q.cond.Broadcast()
+	q.mu.Unlock()
+	q.wg.Wait()
+}
+
+// enqueue adds a fragment to the queue or, if it is already queued, updates
+// its priority. Fragments which are being snapshotted are ignored.
+func (q *snapshotQueue) enqueue(f *Fragment, opN int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.running[f]; ok || q.closed {
+		return
+	} else if item := q.queued[f]; item != nil {
+		item.opN = opN
+		heap.Fix(&q.items, item.index)
+		return
+	}
+
+	item := &snapshotQueueItem{fragment: f, opN: opN}
+	heap.Push(&q.items, item)
+	q.queued[f] = item
+	q.cond.Signal()
+}
+
+// run snapshots fragments from the queue until it is closed.
+func (q *snapshotQueue) run() {
+	for {
+		f := q.next()
+		if f == nil {
+			return
+		}
+
+		if err := f.backgroundSnapshot(); err != nil {
+			q.logger.Printf("error snapshotting fragment: err=%s, path=%s", err, f.Path())
+		}
+
+		q.mu.Lock()
+		delete(q.running, f)
+		q.mu.Unlock()
+	}
+}
+
+// next blocks until a fragment is available and marks it as running.
+// Returns nil once the queue is closed.
+func (q *snapshotQueue) next() *Fragment {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed {
+		return nil
+	}
+
+	item := heap.Pop(&q.items).(*snapshotQueueItem)
+	delete(q.queued, item.fragment)
+	q.running[item.fragment] = struct{}{}
+	return item.fragment
+}
+
+// snapshotQueueItem is a fragment waiting to be snapshotted.
+type snapshotQueueItem struct {
+	fragment *Fragment
+	opN      int
+	index    int
+}
+
+// snapshotQueueItems implements heap.Interface, ordered by largest opN first.
+type snapshotQueueItems []*snapshotQueueItem
+
+func (a snapshotQueueItems) Len() int           { return len(a) }
+func (a snapshotQueueItems) Less(i, j int) bool { return a[i].opN > a[j].opN }
+
+func (a snapshotQueueItems) Swap(i, j int) {
+	a[i], a[j] = a[j], a[i]
+	a[i].index, a[j].index = i, j
+}
+
+func (a *snapshotQueueItems) Push(x interface{}) {
+	item := x.(*snapshotQueueItem)
+	item.index = len(*a)
+	*a = append(*a, item)
+}
+
+func (a *snapshotQueueItems) Pop() interface{} {
+	old := *a
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*a = old[:len(old)-1]
+	return item
+}
+
 // RecalculateCaches recalculates caches on every index in the holder. This is
 // probably not practical to call in real-world workloads, but makes writing
 // integration tests much eaiser, since one doesn't have to wait 10 seconds
@@ -450,6 +743,11 @@ func (s *HolderSyncer) IsClosing() bool {
 
 // SyncHolder compares the holder on host with the local holder and resolves differences.
 func (s *HolderSyncer) SyncHolder() error {
+	// Reconcile schema changes which may have missed a broadcast.
+	if err := s.syncSchema(); err != nil {
+		return fmt.Errorf("schema sync error: err=%s", err)
+	}
+
 	// Iterate over schema in sorted order.
 	for _, di := range s.Holder.Schema() {
 		// Verify syncer has not closed.
@@ -502,6 +800,154 @@ func (s *HolderSyncer) SyncHolder() error {
 	return nil
 }
 
+// syncSchema reconciles fields & time quantums with the last known status
+// of every other node. Each index & frame adopts the schema of the node with
+// the most recent schema version, so changes which missed a broadcast are
+// applied, including deleted fields & changed time quantums. Equal versions
+// with different schemas are ordered by host so that every node agrees on
+// the same schema.
+func (s *HolderSyncer) syncSchema() error {
+	for _, node := range Nodes(s.Cluster.Nodes).FilterHost(s.URI.HostPort()) {
+		// Verify syncer has not closed.
+		if s.IsClosing() {
+			return nil
+		}
+
+		// Skip nodes which have not reported their status yet.
+		if node.status == nil {
+			continue
+		}
+
+		for _, pbIndex := range node.status.Indexes {
+			idx := s.Holder.Index(pbIndex.Name)
+			if idx == nil {
+				continue
+			}
+
+			q := TimeQuantum(pbIndex.Meta.GetTimeQuantum())
+			if v := pbIndex.Meta.GetSchemaVersion(); s.newerSchema(v, idx.SchemaVersion(), node.Host, q != idx.TimeQuantum()) {
+				if err := idx.SetTimeQuantum(q); err != nil {
+					return err
+				} else if err := idx.setSchemaVersion(v); err != nil {
+					return err
+				}
+			}
+
+			for _, pbFrame := range pbIndex.Frames {
+				f := idx.Frame(pbFrame.Name)
+				if f == nil {
+					continue
+				}
+
+				q, fields := TimeQuantum(pbFrame.Meta.GetTimeQuantum()), decodeFields(pbFrame.Meta.GetFields())
+				local := f.SchemaVersion()
+				if v := pbFrame.Meta.GetSchemaVersion(); s.newerSchema(v, local, node.Host, !frameSchemaEqual(f, q, fields)) {
+					// Restore the previous version if any field could not be
+					// reconciled so that the remote schema is retried on the
+					// next sync.
+					ok, err := s.syncFrameSchema(f, q, fields)
+					if err != nil {
+						return err
+					} else if !ok {
+						v = local
+					}
+					if err := f.setSchemaVersion(v); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// newerSchema returns true if a remote schema should replace the local
+// schema. A schema with an equal version but different contents replaces the
+// local schema if the remote host orders after the local host.
+func (s *HolderSyncer) newerSchema(v, local uint64, host string, changed bool) bool {
+	if v != local {
+		return v > local
+	}
+	return changed && host > s.URI.HostPort()
+}
+
+// frameSchemaEqual returns true if f has the time quantum & fields.
+func frameSchemaEqual(f *Frame, q TimeQuantum, fields []*Field) bool {
+	if q != f.TimeQuantum() {
+		return false
+	} else if !f.RangeEnabled() {
+		return true
+	}
+
+	if len(f.Schema().Fields) != len(fields) {
+		return false
+	}
+	for _, field := range fields {
+		if local := f.Field(field.Name); local == nil || *local != *field {
+			return false
+		}
+	}
+	return true
+}
+
+// syncFrameSchema replaces the time quantum & fields of f. Fields which
+// cannot be changed, such as a range which excludes existing values, are
+// logged & skipped. Returns false if any field was skipped.
+func (s *HolderSyncer) syncFrameSchema(f *Frame, q TimeQuantum, fields []*Field) (bool, error) {
+	if q != f.TimeQuantum() {
+		if err := f.SetTimeQuantum(q); err != nil {
+			return false, err
+		}
+	}
+
+	// Fields are only allowed on range-enabled frames.
+	if !f.RangeEnabled() {
+		return true, nil
+	}
+
+	m := make(map[string]*Field, len(fields))
+	for _, field := range fields {
+		m[field.Name] = field
+	}
+
+	// Remove fields which no longer exist remotely.
+	for _, field := range f.Schema().Fields {
+		if m[field.Name] == nil {
+			if err := f.DeleteField(field.Name); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	// Create missing fields, re-create fields whose type changed and
+	// re-range fields whose range changed.
+	ok := true
+	for _, field := range fields {
+		local := f.Field(field.Name)
+		if local != nil && *local == *field {
+			continue
+		}
+
+		var err error
+		if local == nil {
+			err = f.CreateField(field)
+		} else if local.Type != field.Type || local.Scale != field.Scale || local.Unit != field.Unit {
+			if err = f.DeleteField(field.Name); err == nil {
+				err = f.CreateField(field)
+			}
+		} else {
+			err = f.RerangeField(field.Name, field.Min, field.Max)
+		}
+		if err != nil {
+			s.Holder.logger().Printf("cannot sync field: index=%s, frame=%s, field=%s, err=%s", f.Index(), f.Name(), field.Name, err)
+			ok = false
+		}
+	}
+
+	return ok, nil
+}
+
 // syncIndex synchronizes index attributes with the rest of the cluster.
 func (s *HolderSyncer) syncIndex(index string) error {
 	// Retrieve index reference.
@@ -15,14 +15,17 @@
 package pilosa_test
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pilosa/pilosa"
+	"github.com/pilosa/pilosa/internal"
 	"github.com/pilosa/pilosa/pql"
 	"github.com/pilosa/pilosa/test"
 )
@@ -64,6 +67,26 @@ func TestHolder_Open(t *testing.T) {
 			t.Fatalf("unexpected error: %s", err)
 		}
 	})
+	t.Run("ErrFsyncPolicy", func(t *testing.T) {
+		h := test.NewHolder()
+		defer h.Close()
+
+		h.FsyncPolicy = "invalid"
+		if err := h.Open(); err != pilosa.ErrInvalidFsyncPolicy {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+	t.Run("ErrFsyncInterval", func(t *testing.T) {
+		h := test.NewHolder()
+		defer h.Close()
+
+		h.FsyncPolicy = pilosa.FsyncInterval
+		h.FsyncInterval = 0
+		if err := h.Open(); err != pilosa.ErrInvalidFsyncInterval {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
 	t.Run("ErrIndexMetaCorrupt", func(t *testing.T) {
 		h := test.MustOpenHolder()
 		defer h.Close()
@@ -317,6 +340,67 @@ func TestHolder_DeleteIndex(t *testing.T) {
 	}
 }
 
+// Ensure holder propagates its fsync policy and syncs written fragments.
+func TestHolder_Sync(t *testing.T) {
+	hldr := test.NewHolder()
+	defer hldr.Close()
+	hldr.FsyncPolicy = pilosa.FsyncRequest
+	if err := hldr.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	f := hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0)
+	if f.FsyncPolicy != pilosa.FsyncRequest {
+		t.Fatalf("unexpected fsync policy: %s", f.FsyncPolicy)
+	} else if _, err := f.SetBit(100, 200); err != nil {
+		t.Fatal(err)
+	} else if err := hldr.Sync(); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetBit(100, 201); err != nil {
+		t.Fatal(err)
+	} else if err := hldr.Sync(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Ensure fragments are snapshotted in the background while writes continue.
+func TestHolder_SnapshotQueue(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+
+	f := hldr.MustCreateFragmentIfNotExists("i", "f", pilosa.ViewStandard, 0)
+	f.MaxOpN = 10
+
+	const n = 1000
+	for i := uint64(0); i < n; i++ {
+		if _, err := f.SetBit(100, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Wait for the log to be compacted into a snapshot.
+	for i := 0; ; i++ {
+		fi, err := os.Stat(f.Path())
+		if err != nil {
+			t.Fatal(err)
+		} else if fi.Size() < n*4 {
+			break
+		} else if i == 100 {
+			t.Fatalf("fragment not snapshotted: size=%d", fi.Size())
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// Verify ops written during snapshots are kept.
+	if err := hldr.Holder.Close(); err != nil {
+		t.Fatal(err)
+	} else if err := hldr.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if n := hldr.Fragment("i", "f", pilosa.ViewStandard, 0).Row(100).Count(); n != 1000 {
+		t.Fatalf("unexpected count: %d", n)
+	}
+}
+
 // Ensure holder can sync with a remote holder.
 func TestHolderSyncer_SyncHolder(t *testing.T) {
 	cluster := test.NewCluster(2)
@@ -438,3 +522,169 @@ func TestHolderSyncer_SyncHolder(t *testing.T) {
 		}
 	}
 }
+
+// Ensure holder syncer reconciles fields & time quantums from remote node status.
+func TestHolderSyncer_SyncSchema(t *testing.T) {
+	cluster := test.NewCluster(2)
+
+	hldr0 := test.MustOpenHolder()
+	defer hldr0.Close()
+	hldr1 := test.MustOpenHolder()
+	defer hldr1.Close()
+	s := test.NewServer()
+	defer s.Close()
+	s.Handler.Holder = hldr1.Holder
+	cluster.Nodes[0].Host = "localhost:0"
+	cluster.Nodes[1].Host = test.MustParseURLHost(s.URL)
+
+	// Create the same schema on both nodes.
+	for _, hldr := range []*test.Holder{hldr0, hldr1} {
+		idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+		if _, err := idx.CreateFrameIfNotExists("f", pilosa.FrameOptions{RangeEnabled: true, Fields: []*pilosa.Field{
+			{Name: "y", Type: pilosa.FieldTypeInt, Min: 0, Max: 10},
+		}}); err != nil {
+			t.Fatal(err)
+		} else if _, err := idx.CreateFrameIfNotExists("g", pilosa.FrameOptions{RangeEnabled: true, Fields: []*pilosa.Field{
+			{Name: "z", Type: pilosa.FieldTypeInt, Min: 0, Max: 10},
+		}}); err != nil {
+			t.Fatal(err)
+		} else if err := idx.SetTimeQuantum("Y"); err != nil {
+			t.Fatal(err)
+		} else if err := hldr.Frame("i", "f").SetTimeQuantum("Y"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Apply schema changes to the remote node only.
+	if err := hldr1.Index("i").SetTimeQuantum("YMD"); err != nil {
+		t.Fatal(err)
+	} else if err := hldr1.Frame("i", "f").SetTimeQuantum("YM"); err != nil {
+		t.Fatal(err)
+	} else if err := hldr1.Frame("i", "f").CreateField(&pilosa.Field{Name: "x", Type: pilosa.FieldTypeInt, Min: 0, Max: 100}); err != nil {
+		t.Fatal(err)
+	} else if err := hldr1.Frame("i", "f").DeleteField("y"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete a field locally which the remote node still has.
+	if err := hldr0.Frame("i", "g").DeleteField("z"); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster.Nodes[1].SetStatus(&internal.NodeStatus{
+		Host:    cluster.Nodes[1].Host,
+		State:   pilosa.NodeStateUp,
+		Indexes: pilosa.EncodeIndexes(hldr1.Indexes()),
+	})
+
+	uri, err := cluster.Nodes[0].URI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncer := pilosa.HolderSyncer{
+		Holder:  hldr0.Holder,
+		URI:     uri,
+		Cluster: cluster,
+	}
+	if err := syncer.SyncHolder(); err != nil {
+		t.Fatal(err)
+	}
+
+	if q := hldr0.Index("i").TimeQuantum(); q != "YMD" {
+		t.Fatalf("unexpected index time quantum: %s", q)
+	} else if q := hldr0.Frame("i", "f").TimeQuantum(); q != "YM" {
+		t.Fatalf("unexpected frame time quantum: %s", q)
+	} else if field := hldr0.Frame("i", "f").Field("x"); !reflect.DeepEqual(field, &pilosa.Field{Name: "x", Type: pilosa.FieldTypeInt, Min: 0, Max: 100}) {
+		t.Fatalf("unexpected field: %+v", field)
+	} else if field := hldr0.Frame("i", "f").Field("y"); field != nil {
+		t.Fatalf("expected deleted field: %+v", field)
+	} else if field := hldr0.Frame("i", "g").Field("z"); field != nil {
+		t.Fatalf("expected field to remain deleted: %+v", field)
+	}
+
+	// Versions match the adopted schema.
+	if v0, v1 := hldr0.Frame("i", "f").SchemaVersion(), hldr1.Frame("i", "f").SchemaVersion(); v0 != v1 {
+		t.Fatalf("unexpected frame schema version: %d != %d", v0, v1)
+	} else if v0, v1 := hldr0.Index("i").SchemaVersion(), hldr1.Index("i").SchemaVersion(); v0 != v1 {
+		t.Fatalf("unexpected index schema version: %d != %d", v0, v1)
+	}
+}
+
+// Ensure holder syncer orders equal schema versions by host and skips fields
+// which cannot be reconciled.
+func TestHolderSyncer_SyncSchema_Conflict(t *testing.T) {
+	cluster := test.NewCluster(2)
+
+	hldr0 := test.MustOpenHolder()
+	defer hldr0.Close()
+	var logs bytes.Buffer
+	hldr0.Holder.LogOutput = &lockedWriter{w: &logs}
+	hldr1 := test.MustOpenHolder()
+	defer hldr1.Close()
+	s := test.NewServer()
+	defer s.Close()
+	s.Handler.Holder = hldr1.Holder
+
+	// The local host orders before the remote host.
+	cluster.Nodes[0].Host = "0.0.0.0:0"
+	cluster.Nodes[1].Host = test.MustParseURLHost(s.URL)
+
+	// Create conflicting fields on each node.
+	if _, err := hldr0.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{}).CreateFrameIfNotExists("f", pilosa.FrameOptions{RangeEnabled: true, Fields: []*pilosa.Field{
+		{Name: "x", Type: pilosa.FieldTypeInt, Min: 0, Max: 10},
+		{Name: "y", Type: pilosa.FieldTypeInt, Min: 0, Max: 100},
+	}}); err != nil {
+		t.Fatal(err)
+	} else if _, err := hldr0.Frame("i", "f").SetFieldValue(1, "y", 50); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := hldr1.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{}).CreateFrameIfNotExists("f", pilosa.FrameOptions{RangeEnabled: true, Fields: []*pilosa.Field{
+		{Name: "x", Type: pilosa.FieldTypeDecimal, Scale: 2, Min: 0, Max: 1000},
+		{Name: "y", Type: pilosa.FieldTypeInt, Min: 0, Max: 5},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Report the remote schema with the same version as the local schema.
+	version := hldr0.Frame("i", "f").SchemaVersion()
+	pbIndexes := pilosa.EncodeIndexes(hldr1.Indexes())
+	for _, pbFrame := range pbIndexes[0].Frames {
+		pbFrame.Meta.SchemaVersion = version
+	}
+	cluster.Nodes[1].SetStatus(&internal.NodeStatus{
+		Host:    cluster.Nodes[1].Host,
+		State:   pilosa.NodeStateUp,
+		Indexes: pbIndexes,
+	})
+
+	uri, err := cluster.Nodes[0].URI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncer := pilosa.HolderSyncer{
+		Holder:  hldr0.Holder,
+		URI:     uri,
+		Cluster: cluster,
+	}
+	if err := syncer.SyncHolder(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The field type change is applied but the range which excludes existing
+	// values is skipped and the version is kept so it is retried.
+	f := hldr0.Frame("i", "f")
+	if field := f.Field("x"); !reflect.DeepEqual(field, &pilosa.Field{Name: "x", Type: pilosa.FieldTypeDecimal, Scale: 2, Min: 0, Max: 1000}) {
+		t.Fatalf("unexpected field: %+v", field)
+	} else if field := f.Field("y"); field.Max != 100 {
+		t.Fatalf("unexpected field: %+v", field)
+	} else if v := f.SchemaVersion(); v != version {
+		t.Fatalf("unexpected schema version: %d != %d", v, version)
+	}
+
+	lw := hldr0.Holder.LogOutput.(*lockedWriter)
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+	if !strings.Contains(logs.String(), "cannot sync field: index=i, frame=f, field=y") {
+		t.Fatalf("unexpected logs: %s", logs.String())
+	}
+}
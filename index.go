@@ -33,6 +33,7 @@ import (
 const (
 	DefaultColumnLabel = "columnID"
 	InputDefinitionDir = ".input-definitions"
+	ExistenceDir       = ".existence"
 )
 
 // Index represents a container for frames.
@@ -45,9 +46,17 @@ type Index struct {
 	// This can be overridden by individual frames.
 	timeQuantum TimeQuantum
 
+	// Incremented on every time quantum change so that nodes can
+	// reconcile with the most recent version.
+	schemaVersion uint64
+
 	// Label used for referring to columns in index.
 	columnLabel string
 
+	// Translates string keys to column IDs, if enabled.
+	keys           bool
+	translateStore *TranslateStore
+
 	// Frames by name.
 	frames map[string]*Frame
 
@@ -58,12 +67,18 @@ type Index struct {
 	// Column attribute storage and cache.
 	columnAttrStore *AttrStore
 
+	// Tracks every column that has been set in any frame of the index.
+	existence *View
+
 	// InputDefinitions by name.
 	inputDefinitions map[string]*InputDefinition
 
 	broadcaster Broadcaster
 	Stats       StatsClient
 
+	syncer        *fragmentSyncer
+	snapshotQueue *snapshotQueue
+
 	LogOutput io.Writer
 }
 
@@ -84,6 +99,8 @@ func NewIndex(path, name string) (*Index, error) {
 		remoteMaxInverseSlice: 0,
 
 		columnAttrStore: NewAttrStore(filepath.Join(path, ".data")),
+		translateStore:  NewTranslateStore(filepath.Join(path, TranslateFile)),
+		existence:       NewView(filepath.Join(path, ExistenceDir), name, "", ViewStandard, DefaultCacheSize),
 
 		columnLabel: DefaultColumnLabel,
 
@@ -102,6 +119,13 @@ func (i *Index) Path() string { return i.path }
 // ColumnAttrStore returns the storage for column attributes.
 func (i *Index) ColumnAttrStore() *AttrStore { return i.columnAttrStore }
 
+// TranslateStore returns the storage for column key translation.
+func (i *Index) TranslateStore() *TranslateStore { return i.translateStore }
+
+// ExistenceView returns the view which tracks the columns that exist in the index.
+// All existing columns are stored in row zero of the view.
+func (i *Index) ExistenceView() *View { return i.existence }
+
 // SetColumnLabel sets the column label. Persists to meta file on update.
 func (i *Index) SetColumnLabel(v string) error {
 	i.mu.Lock()
@@ -135,6 +159,37 @@ func (i *Index) ColumnLabel() string {
 	return v
 }
 
+// Keys returns true if the index translates string keys to column IDs.
+func (i *Index) Keys() bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.keys
+}
+
+// SetKeys enables key translation for columns. Persists to meta file on update.
+// Key translation cannot be disabled once enabled.
+func (i *Index) SetKeys(v bool) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	// Ignore if no change occurred.
+	if !v || i.keys {
+		return nil
+	}
+
+	if err := i.translateStore.Open(); err != nil {
+		return err
+	}
+
+	// Persist meta data to disk on change.
+	i.keys = v
+	if err := i.saveMeta(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Options returns all options for this index.
 func (i *Index) Options() IndexOptions {
 	i.mu.RLock()
@@ -146,6 +201,7 @@ func (i *Index) options() IndexOptions {
 	return IndexOptions{
 		ColumnLabel: i.columnLabel,
 		TimeQuantum: i.timeQuantum,
+		Keys:        i.keys,
 	}
 }
 
@@ -161,6 +217,10 @@ func (i *Index) Open() error {
 		return err
 	}
 
+	if err := i.openExistence(); err != nil {
+		return err
+	}
+
 	if err := i.openFrames(); err != nil {
 		return err
 	}
@@ -169,6 +229,12 @@ func (i *Index) Open() error {
 		return err
 	}
 
+	if i.keys {
+		if err := i.translateStore.Open(); err != nil {
+			return err
+		}
+	}
+
 	if err := i.openInputDefinitions(); err != nil {
 		return err
 	}
@@ -190,7 +256,7 @@ func (i *Index) openFrames() error {
 	}
 
 	for _, fi := range fis {
-		if !fi.IsDir() || fi.Name() == InputDefinitionDir {
+		if !fi.IsDir() || fi.Name() == InputDefinitionDir || fi.Name() == ExistenceDir {
 			continue
 		}
 
@@ -206,6 +272,19 @@ func (i *Index) openFrames() error {
 	return nil
 }
 
+// openExistence opens and initializes the column existence view.
+func (i *Index) openExistence() error {
+	i.existence.cacheType = CacheTypeNone
+	i.existence.LogOutput = i.LogOutput
+	i.existence.broadcaster = i.broadcaster
+	i.existence.syncer = i.syncer
+	i.existence.snapshotQueue = i.snapshotQueue
+	if err := i.existence.Open(); err != nil {
+		return fmt.Errorf("open existence view: %s", err)
+	}
+	return nil
+}
+
 // loadMeta reads meta data for the index, if any.
 func (i *Index) loadMeta() error {
 	var pb internal.IndexMeta
@@ -215,6 +294,8 @@ func (i *Index) loadMeta() error {
 	if os.IsNotExist(err) {
 		i.timeQuantum = ""
 		i.columnLabel = DefaultColumnLabel
+		i.keys = false
+		i.schemaVersion = 0
 		return nil
 	} else if err != nil {
 		return err
@@ -227,6 +308,8 @@ func (i *Index) loadMeta() error {
 	// Copy metadata fields.
 	i.timeQuantum = TimeQuantum(pb.TimeQuantum)
 	i.columnLabel = pb.ColumnLabel
+	i.keys = pb.Keys
+	i.schemaVersion = pb.SchemaVersion
 
 	return nil
 }
@@ -235,8 +318,10 @@ func (i *Index) loadMeta() error {
 func (i *Index) saveMeta() error {
 	// Marshal metadata.
 	buf, err := proto.Marshal(&internal.IndexMeta{
-		TimeQuantum: string(i.timeQuantum),
-		ColumnLabel: i.columnLabel,
+		TimeQuantum:   string(i.timeQuantum),
+		ColumnLabel:   i.columnLabel,
+		Keys:          i.keys,
+		SchemaVersion: i.schemaVersion,
 	})
 	if err != nil {
 		return err
@@ -260,6 +345,11 @@ func (i *Index) Close() error {
 		i.columnAttrStore.Close()
 	}
 
+	// Close the key translation store.
+	if i.translateStore != nil {
+		i.translateStore.Close()
+	}
+
 	// Close all frames.
 	for _, f := range i.frames {
 		if err := f.Close(); err != nil {
@@ -268,6 +358,11 @@ func (i *Index) Close() error {
 	}
 	i.frames = make(map[string]*Frame)
 
+	// Close the existence view.
+	if err := i.existence.Close(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -339,7 +434,10 @@ func (i *Index) SetTimeQuantum(q TimeQuantum) error {
 	}
 
 	// Update value on index.
-	i.timeQuantum = q
+	if q != i.timeQuantum {
+		i.timeQuantum = q
+		i.schemaVersion = nextSchemaVersion(i.schemaVersion)
+	}
 
 	// Perist meta data to disk.
 	if err := i.saveMeta(); err != nil {
@@ -349,6 +447,21 @@ func (i *Index) SetTimeQuantum(q TimeQuantum) error {
 	return nil
 }
 
+// SchemaVersion returns the version of the index's time quantum.
+func (i *Index) SchemaVersion() uint64 {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.schemaVersion
+}
+
+// setSchemaVersion sets the schema version after adopting a remote schema.
+func (i *Index) setSchemaVersion(v uint64) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.schemaVersion = v
+	return i.saveMeta()
+}
+
 // FramePath returns the path to a frame in the index.
 func (i *Index) FramePath(name string) string { return filepath.Join(i.path, name) }
 
@@ -429,6 +542,10 @@ func (i *Index) createFrame(name string, opt FrameOptions) (*Frame, error) {
 		return nil, errors.New("frame name required")
 	} else if opt.CacheType != "" && !IsValidCacheType(opt.CacheType) {
 		return nil, ErrInvalidCacheType
+	} else if !IsValidCompression(opt.Compression) {
+		return nil, ErrInvalidCompression
+	} else if len(opt.CompressedViews) > 0 && opt.Compression == CompressionNone {
+		return nil, ErrCompressionRequired
 	}
 
 	// Validate that row label does not match column label.
@@ -493,12 +610,23 @@ func (i *Index) createFrame(name string, opt FrameOptions) (*Frame, error) {
 
 	f.inverseEnabled = opt.InverseEnabled
 	f.rangeEnabled = opt.RangeEnabled
+	f.keys = opt.Keys
+	f.compression = opt.Compression
+	f.compressedViews = opt.CompressedViews
 
 	if err := f.saveMeta(); err != nil {
 		f.Close()
 		return nil, err
 	}
 
+	// Open key translation store, if enabled.
+	if f.keys {
+		if err := f.translateStore.Open(); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+
 	f.rangeEnabled = opt.RangeEnabled
 
 	// Set schema & save.
@@ -524,6 +652,9 @@ func (i *Index) newFrame(path, name string) (*Frame, error) {
 	f.LogOutput = i.LogOutput
 	f.Stats = i.Stats.WithTags(fmt.Sprintf("frame:%s", name))
 	f.broadcaster = i.broadcaster
+	f.syncer = i.syncer
+	f.snapshotQueue = i.snapshotQueue
+	f.existence = i.existence
 	return f, nil
 }
 
@@ -624,9 +755,11 @@ func EncodeIndexes(a []*Index) []*internal.Index {
 // encodeIndex converts d into its internal representation.
 func encodeIndex(d *Index) *internal.Index {
 	io := d.options()
+	meta := io.Encode()
+	meta.SchemaVersion = d.SchemaVersion()
 	return &internal.Index{
 		Name:     d.name,
-		Meta:     io.Encode(),
+		Meta:     meta,
 		MaxSlice: d.MaxSlice(),
 		Frames:   encodeFrames(d.Frames()),
 	}
@@ -636,6 +769,7 @@ func encodeIndex(d *Index) *internal.Index {
 type IndexOptions struct {
 	ColumnLabel string      `json:"columnLabel,omitempty"`
 	TimeQuantum TimeQuantum `json:"timeQuantum,omitempty"`
+	Keys        bool        `json:"keys,omitempty"`
 }
 
 // Encode converts i into its internal representation.
@@ -643,6 +777,7 @@ func (i *IndexOptions) Encode() *internal.IndexMeta {
 	return &internal.IndexMeta{
 		ColumnLabel: i.ColumnLabel,
 		TimeQuantum: string(i.TimeQuantum),
+		Keys:        i.Keys,
 	}
 }
 
@@ -91,6 +91,68 @@ func TestIndex_CreateFrame(t *testing.T) {
 		})
 	})
 
+	// Ensure snapshot compression can be enabled for some or all views.
+	t.Run("Compression", func(t *testing.T) {
+		t.Run("OK", func(t *testing.T) {
+			index := test.MustOpenIndex()
+			defer index.Close()
+
+			if _, err := index.CreateFrame("f", pilosa.FrameOptions{
+				Compression:     pilosa.CompressionGzip,
+				CompressedViews: []string{"standard_2015"},
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			// Reopen the index & verify only matching views are compressed.
+			if err := index.Reopen(); err != nil {
+				t.Fatal(err)
+			}
+			f := index.Frame("f")
+			if opt := f.Options(); opt.Compression != pilosa.CompressionGzip || !reflect.DeepEqual(opt.CompressedViews, []string{"standard_2015"}) {
+				t.Fatalf("unexpected options after reopen: %#v", opt)
+			}
+			for _, tt := range []struct {
+				view        string
+				compression string
+			}{
+				{"standard_2015", pilosa.CompressionGzip},
+				{"standard_201501", pilosa.CompressionGzip},
+				{"standard_2016", pilosa.CompressionNone},
+				{pilosa.ViewStandard, pilosa.CompressionNone},
+			} {
+				v, err := f.CreateViewIfNotExists(tt.view)
+				if err != nil {
+					t.Fatal(err)
+				}
+				frag, err := v.CreateFragmentIfNotExists(0)
+				if err != nil {
+					t.Fatal(err)
+				} else if frag.Compression != tt.compression {
+					t.Fatalf("unexpected compression for %s: %q", tt.view, frag.Compression)
+				}
+			}
+		})
+
+		t.Run("ErrInvalidCompression", func(t *testing.T) {
+			index := test.MustOpenIndex()
+			defer index.Close()
+
+			if _, err := index.CreateFrame("f", pilosa.FrameOptions{Compression: "lz9"}); err != pilosa.ErrInvalidCompression {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+
+		t.Run("ErrCompressionRequired", func(t *testing.T) {
+			index := test.MustOpenIndex()
+			defer index.Close()
+
+			if _, err := index.CreateFrame("f", pilosa.FrameOptions{CompressedViews: []string{"standard"}}); err != pilosa.ErrCompressionRequired {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	})
+
 	// Ensure frame can include range columns.
 	t.Run("RangeEnabled", func(t *testing.T) {
 		t.Run("OK", func(t *testing.T) {
@@ -11,13 +11,20 @@
 		Bitmap
 		Pair
 		SumCount
+		ValCount
+		GroupCount
 		Bit
 		ColumnAttrSet
 		Attr
 		AttrMap
 		QueryRequest
 		QueryResponse
+		Profile
 		QueryResult
+		CallPlan
+		ViewPlan
+		NodePlan
+		FragmentPlan
 		ImportRequest
 		ImportValueRequest
 */
@@ -43,8 +50,11 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Bitmap struct {
-	Bits  []uint64 `protobuf:"varint,1,rep,packed,name=Bits" json:"Bits,omitempty"`
-	Attrs []*Attr  `protobuf:"bytes,2,rep,name=Attrs" json:"Attrs,omitempty"`
+	Bits    []uint64 `protobuf:"varint,1,rep,packed,name=Bits" json:"Bits,omitempty"`
+	Attrs   []*Attr  `protobuf:"bytes,2,rep,name=Attrs" json:"Attrs,omitempty"`
+	Keys    []string `protobuf:"bytes,3,rep,name=Keys" json:"Keys,omitempty"`
+	Cursor  string   `protobuf:"bytes,4,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Roaring []byte   `protobuf:"bytes,5,opt,name=Roaring,proto3" json:"Roaring,omitempty"`
 }
 
 func (m *Bitmap) Reset()                    { *m = Bitmap{} }
@@ -66,9 +76,31 @@ func (m *Bitmap) GetAttrs() []*Attr {
 	return nil
 }
 
+func (m *Bitmap) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *Bitmap) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *Bitmap) GetRoaring() []byte {
+	if m != nil {
+		return m.Roaring
+	}
+	return nil
+}
+
 type Pair struct {
-	Key   uint64 `protobuf:"varint,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	Count uint64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Key       uint64 `protobuf:"varint,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Count     uint64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	StringKey string `protobuf:"bytes,3,opt,name=StringKey,proto3" json:"StringKey,omitempty"`
 }
 
 func (m *Pair) Reset()                    { *m = Pair{} }
@@ -90,9 +122,17 @@ func (m *Pair) GetCount() uint64 {
 	return 0
 }
 
+func (m *Pair) GetStringKey() string {
+	if m != nil {
+		return m.StringKey
+	}
+	return ""
+}
+
 type SumCount struct {
-	Sum   int64 `protobuf:"varint,1,opt,name=Sum,proto3" json:"Sum,omitempty"`
-	Count int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Sum   int64  `protobuf:"varint,1,opt,name=Sum,proto3" json:"Sum,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Scale uint32 `protobuf:"varint,3,opt,name=Scale,proto3" json:"Scale,omitempty"`
 }
 
 func (m *SumCount) Reset()                    { *m = SumCount{} }
@@ -114,6 +154,69 @@ func (m *SumCount) GetCount() int64 {
 	return 0
 }
 
+func (m *SumCount) GetScale() uint32 {
+	if m != nil {
+		return m.Scale
+	}
+	return 0
+}
+
+type ValCount struct {
+	Val   int64  `protobuf:"varint,1,opt,name=Val,proto3" json:"Val,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Scale uint32 `protobuf:"varint,3,opt,name=Scale,proto3" json:"Scale,omitempty"`
+}
+
+func (m *ValCount) Reset()                    { *m = ValCount{} }
+func (m *ValCount) String() string            { return proto.CompactTextString(m) }
+func (*ValCount) ProtoMessage()               {}
+func (*ValCount) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{3} }
+
+func (m *ValCount) GetVal() int64 {
+	if m != nil {
+		return m.Val
+	}
+	return 0
+}
+
+func (m *ValCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ValCount) GetScale() uint32 {
+	if m != nil {
+		return m.Scale
+	}
+	return 0
+}
+
+type GroupCount struct {
+	Group []uint64 `protobuf:"varint,1,rep,packed,name=Group" json:"Group,omitempty"`
+	Count uint64   `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (m *GroupCount) Reset()                    { *m = GroupCount{} }
+func (m *GroupCount) String() string            { return proto.CompactTextString(m) }
+func (*GroupCount) ProtoMessage()               {}
+func (*GroupCount) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{4} }
+
+func (m *GroupCount) GetGroup() []uint64 {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (m *GroupCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type Bit struct {
 	RowID     uint64 `protobuf:"varint,1,opt,name=RowID,proto3" json:"RowID,omitempty"`
 	ColumnID  uint64 `protobuf:"varint,2,opt,name=ColumnID,proto3" json:"ColumnID,omitempty"`
@@ -123,7 +226,7 @@ type Bit struct {
 func (m *Bit) Reset()                    { *m = Bit{} }
 func (m *Bit) String() string            { return proto.CompactTextString(m) }
 func (*Bit) ProtoMessage()               {}
-func (*Bit) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{3} }
+func (*Bit) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{5} }
 
 func (m *Bit) GetRowID() uint64 {
 	if m != nil {
@@ -149,12 +252,13 @@ func (m *Bit) GetTimestamp() int64 {
 type ColumnAttrSet struct {
 	ID    uint64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Attrs []*Attr `protobuf:"bytes,2,rep,name=Attrs" json:"Attrs,omitempty"`
+	Key   string  `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
 }
 
 func (m *ColumnAttrSet) Reset()                    { *m = ColumnAttrSet{} }
 func (m *ColumnAttrSet) String() string            { return proto.CompactTextString(m) }
 func (*ColumnAttrSet) ProtoMessage()               {}
-func (*ColumnAttrSet) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{4} }
+func (*ColumnAttrSet) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{6} }
 
 func (m *ColumnAttrSet) GetID() uint64 {
 	if m != nil {
@@ -170,6 +274,13 @@ func (m *ColumnAttrSet) GetAttrs() []*Attr {
 	return nil
 }
 
+func (m *ColumnAttrSet) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
 type Attr struct {
 	Key         string  `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
 	Type        uint64  `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
@@ -182,7 +293,7 @@ type Attr struct {
 func (m *Attr) Reset()                    { *m = Attr{} }
 func (m *Attr) String() string            { return proto.CompactTextString(m) }
 func (*Attr) ProtoMessage()               {}
-func (*Attr) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{5} }
+func (*Attr) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{7} }
 
 func (m *Attr) GetKey() string {
 	if m != nil {
@@ -233,7 +344,7 @@ type AttrMap struct {
 func (m *AttrMap) Reset()                    { *m = AttrMap{} }
 func (m *AttrMap) String() string            { return proto.CompactTextString(m) }
 func (*AttrMap) ProtoMessage()               {}
-func (*AttrMap) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{6} }
+func (*AttrMap) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{8} }
 
 func (m *AttrMap) GetAttrs() []*Attr {
 	if m != nil {
@@ -243,18 +354,29 @@ func (m *AttrMap) GetAttrs() []*Attr {
 }
 
 type QueryRequest struct {
-	Query        string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
-	Slices       []uint64 `protobuf:"varint,2,rep,packed,name=Slices" json:"Slices,omitempty"`
-	ColumnAttrs  bool     `protobuf:"varint,3,opt,name=ColumnAttrs,proto3" json:"ColumnAttrs,omitempty"`
-	Remote       bool     `protobuf:"varint,5,opt,name=Remote,proto3" json:"Remote,omitempty"`
-	ExcludeAttrs bool     `protobuf:"varint,6,opt,name=ExcludeAttrs,proto3" json:"ExcludeAttrs,omitempty"`
-	ExcludeBits  bool     `protobuf:"varint,7,opt,name=ExcludeBits,proto3" json:"ExcludeBits,omitempty"`
+	Query             string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Slices            []uint64 `protobuf:"varint,2,rep,packed,name=Slices" json:"Slices,omitempty"`
+	ColumnAttrs       bool     `protobuf:"varint,3,opt,name=ColumnAttrs,proto3" json:"ColumnAttrs,omitempty"`
+	Remote            bool     `protobuf:"varint,5,opt,name=Remote,proto3" json:"Remote,omitempty"`
+	ExcludeAttrs      bool     `protobuf:"varint,6,opt,name=ExcludeAttrs,proto3" json:"ExcludeAttrs,omitempty"`
+	ExcludeBits       bool     `protobuf:"varint,7,opt,name=ExcludeBits,proto3" json:"ExcludeBits,omitempty"`
+	Explain           bool     `protobuf:"varint,8,opt,name=Explain,proto3" json:"Explain,omitempty"`
+	Profile           bool     `protobuf:"varint,9,opt,name=Profile,proto3" json:"Profile,omitempty"`
+	Timeout           int64    `protobuf:"varint,10,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
+	AttrKeys          []string `protobuf:"bytes,11,rep,name=AttrKeys" json:"AttrKeys,omitempty"`
+	ColumnAttrsLimit  uint64   `protobuf:"varint,12,opt,name=ColumnAttrsLimit,proto3" json:"ColumnAttrsLimit,omitempty"`
+	ColumnAttrsOffset uint64   `protobuf:"varint,13,opt,name=ColumnAttrsOffset,proto3" json:"ColumnAttrsOffset,omitempty"`
+	Limit             uint64   `protobuf:"varint,14,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset            uint64   `protobuf:"varint,15,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Cursor            string   `protobuf:"bytes,16,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	BitmapFormat      string   `protobuf:"bytes,17,opt,name=BitmapFormat,proto3" json:"BitmapFormat,omitempty"`
+	QueryID           string   `protobuf:"bytes,18,opt,name=QueryID,proto3" json:"QueryID,omitempty"`
 }
 
 func (m *QueryRequest) Reset()                    { *m = QueryRequest{} }
 func (m *QueryRequest) String() string            { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()               {}
-func (*QueryRequest) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{7} }
+func (*QueryRequest) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{9} }
 
 func (m *QueryRequest) GetQuery() string {
 	if m != nil {
@@ -298,16 +420,94 @@ func (m *QueryRequest) GetExcludeBits() bool {
 	return false
 }
 
+func (m *QueryRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
+func (m *QueryRequest) GetProfile() bool {
+	if m != nil {
+		return m.Profile
+	}
+	return false
+}
+
+func (m *QueryRequest) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetAttrKeys() []string {
+	if m != nil {
+		return m.AttrKeys
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetColumnAttrsLimit() uint64 {
+	if m != nil {
+		return m.ColumnAttrsLimit
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetColumnAttrsOffset() uint64 {
+	if m != nil {
+		return m.ColumnAttrsOffset
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetBitmapFormat() string {
+	if m != nil {
+		return m.BitmapFormat
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetQueryID() string {
+	if m != nil {
+		return m.QueryID
+	}
+	return ""
+}
+
 type QueryResponse struct {
 	Err            string           `protobuf:"bytes,1,opt,name=Err,proto3" json:"Err,omitempty"`
 	Results        []*QueryResult   `protobuf:"bytes,2,rep,name=Results" json:"Results,omitempty"`
 	ColumnAttrSets []*ColumnAttrSet `protobuf:"bytes,3,rep,name=ColumnAttrSets" json:"ColumnAttrSets,omitempty"`
+	Profile        *Profile         `protobuf:"bytes,4,opt,name=Profile" json:"Profile,omitempty"`
 }
 
 func (m *QueryResponse) Reset()                    { *m = QueryResponse{} }
 func (m *QueryResponse) String() string            { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()               {}
-func (*QueryResponse) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{8} }
+func (*QueryResponse) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{10} }
 
 func (m *QueryResponse) GetErr() string {
 	if m != nil {
@@ -330,18 +530,92 @@ func (m *QueryResponse) GetColumnAttrSets() []*ColumnAttrSet {
 	return nil
 }
 
+func (m *QueryResponse) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type Profile struct {
+	Name     string     `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Call     string     `protobuf:"bytes,2,opt,name=Call,proto3" json:"Call,omitempty"`
+	Host     string     `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
+	Slices   []uint64   `protobuf:"varint,4,rep,packed,name=Slices" json:"Slices,omitempty"`
+	Duration int64      `protobuf:"varint,5,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Count    uint64     `protobuf:"varint,6,opt,name=Count,proto3" json:"Count,omitempty"`
+	Children []*Profile `protobuf:"bytes,7,rep,name=Children" json:"Children,omitempty"`
+}
+
+func (m *Profile) Reset()                    { *m = Profile{} }
+func (m *Profile) String() string            { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()               {}
+func (*Profile) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{11} }
+
+func (m *Profile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Profile) GetCall() string {
+	if m != nil {
+		return m.Call
+	}
+	return ""
+}
+
+func (m *Profile) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *Profile) GetSlices() []uint64 {
+	if m != nil {
+		return m.Slices
+	}
+	return nil
+}
+
+func (m *Profile) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *Profile) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Profile) GetChildren() []*Profile {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
 type QueryResult struct {
-	Bitmap   *Bitmap   `protobuf:"bytes,1,opt,name=Bitmap" json:"Bitmap,omitempty"`
-	N        uint64    `protobuf:"varint,2,opt,name=N,proto3" json:"N,omitempty"`
-	Pairs    []*Pair   `protobuf:"bytes,3,rep,name=Pairs" json:"Pairs,omitempty"`
-	SumCount *SumCount `protobuf:"bytes,5,opt,name=SumCount" json:"SumCount,omitempty"`
-	Changed  bool      `protobuf:"varint,4,opt,name=Changed,proto3" json:"Changed,omitempty"`
+	Bitmap      *Bitmap       `protobuf:"bytes,1,opt,name=Bitmap" json:"Bitmap,omitempty"`
+	N           uint64        `protobuf:"varint,2,opt,name=N,proto3" json:"N,omitempty"`
+	Pairs       []*Pair       `protobuf:"bytes,3,rep,name=Pairs" json:"Pairs,omitempty"`
+	SumCount    *SumCount     `protobuf:"bytes,5,opt,name=SumCount" json:"SumCount,omitempty"`
+	Changed     bool          `protobuf:"varint,4,opt,name=Changed,proto3" json:"Changed,omitempty"`
+	ValCount    *ValCount     `protobuf:"bytes,6,opt,name=ValCount" json:"ValCount,omitempty"`
+	GroupCounts []*GroupCount `protobuf:"bytes,7,rep,name=GroupCounts" json:"GroupCounts,omitempty"`
+	Plan        *CallPlan     `protobuf:"bytes,8,opt,name=Plan" json:"Plan,omitempty"`
 }
 
 func (m *QueryResult) Reset()                    { *m = QueryResult{} }
 func (m *QueryResult) String() string            { return proto.CompactTextString(m) }
 func (*QueryResult) ProtoMessage()               {}
-func (*QueryResult) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{9} }
+func (*QueryResult) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{12} }
 
 func (m *QueryResult) GetBitmap() *Bitmap {
 	if m != nil {
@@ -378,152 +652,332 @@ func (m *QueryResult) GetChanged() bool {
 	return false
 }
 
-type ImportRequest struct {
-	Index      string   `protobuf:"bytes,1,opt,name=Index,proto3" json:"Index,omitempty"`
-	Frame      string   `protobuf:"bytes,2,opt,name=Frame,proto3" json:"Frame,omitempty"`
-	Slice      uint64   `protobuf:"varint,3,opt,name=Slice,proto3" json:"Slice,omitempty"`
-	RowIDs     []uint64 `protobuf:"varint,4,rep,packed,name=RowIDs" json:"RowIDs,omitempty"`
-	ColumnIDs  []uint64 `protobuf:"varint,5,rep,packed,name=ColumnIDs" json:"ColumnIDs,omitempty"`
-	Timestamps []int64  `protobuf:"varint,6,rep,packed,name=Timestamps" json:"Timestamps,omitempty"`
-}
-
-func (m *ImportRequest) Reset()                    { *m = ImportRequest{} }
-func (m *ImportRequest) String() string            { return proto.CompactTextString(m) }
-func (*ImportRequest) ProtoMessage()               {}
-func (*ImportRequest) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{10} }
-
-func (m *ImportRequest) GetIndex() string {
+func (m *QueryResult) GetValCount() *ValCount {
 	if m != nil {
-		return m.Index
+		return m.ValCount
 	}
-	return ""
+	return nil
 }
 
-func (m *ImportRequest) GetFrame() string {
+func (m *QueryResult) GetGroupCounts() []*GroupCount {
 	if m != nil {
-		return m.Frame
+		return m.GroupCounts
 	}
-	return ""
+	return nil
 }
 
-func (m *ImportRequest) GetSlice() uint64 {
+func (m *QueryResult) GetPlan() *CallPlan {
 	if m != nil {
-		return m.Slice
+		return m.Plan
 	}
-	return 0
+	return nil
 }
 
-func (m *ImportRequest) GetRowIDs() []uint64 {
+type CallPlan struct {
+	Call  string      `protobuf:"bytes,1,opt,name=Call,proto3" json:"Call,omitempty"`
+	Views []*ViewPlan `protobuf:"bytes,2,rep,name=Views" json:"Views,omitempty"`
+	Nodes []*NodePlan `protobuf:"bytes,3,rep,name=Nodes" json:"Nodes,omitempty"`
+}
+
+func (m *CallPlan) Reset()                    { *m = CallPlan{} }
+func (m *CallPlan) String() string            { return proto.CompactTextString(m) }
+func (*CallPlan) ProtoMessage()               {}
+func (*CallPlan) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{13} }
+
+func (m *CallPlan) GetCall() string {
 	if m != nil {
-		return m.RowIDs
+		return m.Call
 	}
-	return nil
+	return ""
 }
 
-func (m *ImportRequest) GetColumnIDs() []uint64 {
+func (m *CallPlan) GetViews() []*ViewPlan {
 	if m != nil {
-		return m.ColumnIDs
+		return m.Views
 	}
 	return nil
 }
 
-func (m *ImportRequest) GetTimestamps() []int64 {
+func (m *CallPlan) GetNodes() []*NodePlan {
 	if m != nil {
-		return m.Timestamps
+		return m.Nodes
 	}
 	return nil
 }
 
-type ImportValueRequest struct {
-	Index     string   `protobuf:"bytes,1,opt,name=Index,proto3" json:"Index,omitempty"`
-	Frame     string   `protobuf:"bytes,2,opt,name=Frame,proto3" json:"Frame,omitempty"`
-	Slice     uint64   `protobuf:"varint,3,opt,name=Slice,proto3" json:"Slice,omitempty"`
-	Field     string   `protobuf:"bytes,4,opt,name=Field,proto3" json:"Field,omitempty"`
-	ColumnIDs []uint64 `protobuf:"varint,5,rep,packed,name=ColumnIDs" json:"ColumnIDs,omitempty"`
-	Values    []int64  `protobuf:"varint,6,rep,packed,name=Values" json:"Values,omitempty"`
+type ViewPlan struct {
+	Call  string   `protobuf:"bytes,1,opt,name=Call,proto3" json:"Call,omitempty"`
+	Frame string   `protobuf:"bytes,2,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	Views []string `protobuf:"bytes,3,rep,name=Views" json:"Views,omitempty"`
 }
 
-func (m *ImportValueRequest) Reset()                    { *m = ImportValueRequest{} }
-func (m *ImportValueRequest) String() string            { return proto.CompactTextString(m) }
-func (*ImportValueRequest) ProtoMessage()               {}
-func (*ImportValueRequest) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{11} }
+func (m *ViewPlan) Reset()                    { *m = ViewPlan{} }
+func (m *ViewPlan) String() string            { return proto.CompactTextString(m) }
+func (*ViewPlan) ProtoMessage()               {}
+func (*ViewPlan) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{14} }
 
-func (m *ImportValueRequest) GetIndex() string {
+func (m *ViewPlan) GetCall() string {
 	if m != nil {
-		return m.Index
+		return m.Call
 	}
 	return ""
 }
 
-func (m *ImportValueRequest) GetFrame() string {
+func (m *ViewPlan) GetFrame() string {
 	if m != nil {
 		return m.Frame
 	}
 	return ""
 }
 
-func (m *ImportValueRequest) GetSlice() uint64 {
+func (m *ViewPlan) GetViews() []string {
 	if m != nil {
-		return m.Slice
+		return m.Views
 	}
-	return 0
+	return nil
 }
 
-func (m *ImportValueRequest) GetField() string {
+type NodePlan struct {
+	Host             string          `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"`
+	Slices           []uint64        `protobuf:"varint,2,rep,packed,name=Slices" json:"Slices,omitempty"`
+	MissingFragments []*FragmentPlan `protobuf:"bytes,3,rep,name=MissingFragments" json:"MissingFragments,omitempty"`
+}
+
+func (m *NodePlan) Reset()                    { *m = NodePlan{} }
+func (m *NodePlan) String() string            { return proto.CompactTextString(m) }
+func (*NodePlan) ProtoMessage()               {}
+func (*NodePlan) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{15} }
+
+func (m *NodePlan) GetHost() string {
 	if m != nil {
-		return m.Field
+		return m.Host
 	}
 	return ""
 }
 
-func (m *ImportValueRequest) GetColumnIDs() []uint64 {
+func (m *NodePlan) GetSlices() []uint64 {
 	if m != nil {
-		return m.ColumnIDs
+		return m.Slices
 	}
 	return nil
 }
 
-func (m *ImportValueRequest) GetValues() []int64 {
+func (m *NodePlan) GetMissingFragments() []*FragmentPlan {
 	if m != nil {
-		return m.Values
+		return m.MissingFragments
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Bitmap)(nil), "internal.Bitmap")
-	proto.RegisterType((*Pair)(nil), "internal.Pair")
-	proto.RegisterType((*SumCount)(nil), "internal.SumCount")
-	proto.RegisterType((*Bit)(nil), "internal.Bit")
-	proto.RegisterType((*ColumnAttrSet)(nil), "internal.ColumnAttrSet")
-	proto.RegisterType((*Attr)(nil), "internal.Attr")
-	proto.RegisterType((*AttrMap)(nil), "internal.AttrMap")
-	proto.RegisterType((*QueryRequest)(nil), "internal.QueryRequest")
-	proto.RegisterType((*QueryResponse)(nil), "internal.QueryResponse")
-	proto.RegisterType((*QueryResult)(nil), "internal.QueryResult")
-	proto.RegisterType((*ImportRequest)(nil), "internal.ImportRequest")
-	proto.RegisterType((*ImportValueRequest)(nil), "internal.ImportValueRequest")
+type FragmentPlan struct {
+	Frame string `protobuf:"bytes,1,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	View  string `protobuf:"bytes,2,opt,name=View,proto3" json:"View,omitempty"`
+	Slice uint64 `protobuf:"varint,3,opt,name=Slice,proto3" json:"Slice,omitempty"`
 }
-func (m *Bitmap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+func (m *FragmentPlan) Reset()                    { *m = FragmentPlan{} }
+func (m *FragmentPlan) String() string            { return proto.CompactTextString(m) }
+func (*FragmentPlan) ProtoMessage()               {}
+func (*FragmentPlan) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{16} }
+
+func (m *FragmentPlan) GetFrame() string {
+	if m != nil {
+		return m.Frame
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Bitmap) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bits) > 0 {
-		dAtA2 := make([]byte, len(m.Bits)*10)
-		var j1 int
-		for _, num := range m.Bits {
-			for num >= 1<<7 {
+func (m *FragmentPlan) GetView() string {
+	if m != nil {
+		return m.View
+	}
+	return ""
+}
+
+func (m *FragmentPlan) GetSlice() uint64 {
+	if m != nil {
+		return m.Slice
+	}
+	return 0
+}
+
+type ImportRequest struct {
+	Index      string   `protobuf:"bytes,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Frame      string   `protobuf:"bytes,2,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	Slice      uint64   `protobuf:"varint,3,opt,name=Slice,proto3" json:"Slice,omitempty"`
+	RowIDs     []uint64 `protobuf:"varint,4,rep,packed,name=RowIDs" json:"RowIDs,omitempty"`
+	ColumnIDs  []uint64 `protobuf:"varint,5,rep,packed,name=ColumnIDs" json:"ColumnIDs,omitempty"`
+	Timestamps []int64  `protobuf:"varint,6,rep,packed,name=Timestamps" json:"Timestamps,omitempty"`
+	RowKeys    []string `protobuf:"bytes,7,rep,name=RowKeys" json:"RowKeys,omitempty"`
+	ColumnKeys []string `protobuf:"bytes,8,rep,name=ColumnKeys" json:"ColumnKeys,omitempty"`
+}
+
+func (m *ImportRequest) Reset()                    { *m = ImportRequest{} }
+func (m *ImportRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()               {}
+func (*ImportRequest) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{17} }
+
+func (m *ImportRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ImportRequest) GetFrame() string {
+	if m != nil {
+		return m.Frame
+	}
+	return ""
+}
+
+func (m *ImportRequest) GetSlice() uint64 {
+	if m != nil {
+		return m.Slice
+	}
+	return 0
+}
+
+func (m *ImportRequest) GetRowIDs() []uint64 {
+	if m != nil {
+		return m.RowIDs
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetColumnIDs() []uint64 {
+	if m != nil {
+		return m.ColumnIDs
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetRowKeys() []string {
+	if m != nil {
+		return m.RowKeys
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetColumnKeys() []string {
+	if m != nil {
+		return m.ColumnKeys
+	}
+	return nil
+}
+
+type ImportValueRequest struct {
+	Index         string   `protobuf:"bytes,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Frame         string   `protobuf:"bytes,2,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	Slice         uint64   `protobuf:"varint,3,opt,name=Slice,proto3" json:"Slice,omitempty"`
+	Field         string   `protobuf:"bytes,4,opt,name=Field,proto3" json:"Field,omitempty"`
+	ColumnIDs     []uint64 `protobuf:"varint,5,rep,packed,name=ColumnIDs" json:"ColumnIDs,omitempty"`
+	Values        []int64  `protobuf:"varint,6,rep,packed,name=Values" json:"Values,omitempty"`
+	DecimalValues []string `protobuf:"bytes,7,rep,name=DecimalValues" json:"DecimalValues,omitempty"`
+}
+
+func (m *ImportValueRequest) Reset()                    { *m = ImportValueRequest{} }
+func (m *ImportValueRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportValueRequest) ProtoMessage()               {}
+func (*ImportValueRequest) Descriptor() ([]byte, []int) { return fileDescriptorPublic, []int{18} }
+
+func (m *ImportValueRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ImportValueRequest) GetFrame() string {
+	if m != nil {
+		return m.Frame
+	}
+	return ""
+}
+
+func (m *ImportValueRequest) GetSlice() uint64 {
+	if m != nil {
+		return m.Slice
+	}
+	return 0
+}
+
+func (m *ImportValueRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ImportValueRequest) GetColumnIDs() []uint64 {
+	if m != nil {
+		return m.ColumnIDs
+	}
+	return nil
+}
+
+func (m *ImportValueRequest) GetValues() []int64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ImportValueRequest) GetDecimalValues() []string {
+	if m != nil {
+		return m.DecimalValues
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Bitmap)(nil), "internal.Bitmap")
+	proto.RegisterType((*Pair)(nil), "internal.Pair")
+	proto.RegisterType((*SumCount)(nil), "internal.SumCount")
+	proto.RegisterType((*ValCount)(nil), "internal.ValCount")
+	proto.RegisterType((*GroupCount)(nil), "internal.GroupCount")
+	proto.RegisterType((*Bit)(nil), "internal.Bit")
+	proto.RegisterType((*ColumnAttrSet)(nil), "internal.ColumnAttrSet")
+	proto.RegisterType((*Attr)(nil), "internal.Attr")
+	proto.RegisterType((*AttrMap)(nil), "internal.AttrMap")
+	proto.RegisterType((*QueryRequest)(nil), "internal.QueryRequest")
+	proto.RegisterType((*QueryResponse)(nil), "internal.QueryResponse")
+	proto.RegisterType((*Profile)(nil), "internal.Profile")
+	proto.RegisterType((*QueryResult)(nil), "internal.QueryResult")
+	proto.RegisterType((*CallPlan)(nil), "internal.CallPlan")
+	proto.RegisterType((*ViewPlan)(nil), "internal.ViewPlan")
+	proto.RegisterType((*NodePlan)(nil), "internal.NodePlan")
+	proto.RegisterType((*FragmentPlan)(nil), "internal.FragmentPlan")
+	proto.RegisterType((*ImportRequest)(nil), "internal.ImportRequest")
+	proto.RegisterType((*ImportValueRequest)(nil), "internal.ImportValueRequest")
+}
+func (m *Bitmap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bitmap) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bits) > 0 {
+		dAtA2 := make([]byte, len(m.Bits)*10)
+		var j1 int
+		for _, num := range m.Bits {
+			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
 				j1++
@@ -548,6 +1002,33 @@ func (m *Bitmap) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Cursor) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Cursor)))
+		i += copy(dAtA[i:], m.Cursor)
+	}
+	if len(m.Roaring) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Roaring)))
+		i += copy(dAtA[i:], m.Roaring)
+	}
 	return i, nil
 }
 
@@ -576,6 +1057,12 @@ func (m *Pair) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPublic(dAtA, i, uint64(m.Count))
 	}
+	if len(m.StringKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.StringKey)))
+		i += copy(dAtA[i:], m.StringKey)
+	}
 	return i, nil
 }
 
@@ -604,6 +1091,84 @@ func (m *SumCount) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPublic(dAtA, i, uint64(m.Count))
 	}
+	if m.Scale != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Scale))
+	}
+	return i, nil
+}
+
+func (m *ValCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValCount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Val != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Val))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Count))
+	}
+	if m.Scale != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Scale))
+	}
+	return i, nil
+}
+
+func (m *GroupCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupCount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		dAtA4 := make([]byte, len(m.Group)*10)
+		var j3 int
+		for _, num := range m.Group {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Count))
+	}
 	return i, nil
 }
 
@@ -672,6 +1237,12 @@ func (m *ColumnAttrSet) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
 	return i, nil
 }
 
@@ -783,21 +1354,21 @@ func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.Query)
 	}
 	if len(m.Slices) > 0 {
-		dAtA4 := make([]byte, len(m.Slices)*10)
-		var j3 int
+		dAtA6 := make([]byte, len(m.Slices)*10)
+		var j5 int
 		for _, num := range m.Slices {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j5++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPublic(dAtA, i, uint64(j3))
-		i += copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintPublic(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
 	}
 	if m.ColumnAttrs {
 		dAtA[i] = 0x18
@@ -839,6 +1410,90 @@ func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Explain {
+		dAtA[i] = 0x40
+		i++
+		if m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Profile {
+		dAtA[i] = 0x48
+		i++
+		if m.Profile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Timeout))
+	}
+	if len(m.AttrKeys) > 0 {
+		for _, s := range m.AttrKeys {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ColumnAttrsLimit != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.ColumnAttrsLimit))
+	}
+	if m.ColumnAttrsOffset != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.ColumnAttrsOffset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.Cursor) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Cursor)))
+		i += copy(dAtA[i:], m.Cursor)
+	}
+	if len(m.BitmapFormat) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.BitmapFormat)))
+		i += copy(dAtA[i:], m.BitmapFormat)
+	}
+	if len(m.QueryID) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.QueryID)))
+		i += copy(dAtA[i:], m.QueryID)
+	}
 	return i, nil
 }
 
@@ -887,6 +1542,91 @@ func (m *QueryResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Profile != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Profile.Size()))
+		n7, err := m.Profile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *Profile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Profile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Call) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Call)))
+		i += copy(dAtA[i:], m.Call)
+	}
+	if len(m.Host) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Host)))
+		i += copy(dAtA[i:], m.Host)
+	}
+	if len(m.Slices) > 0 {
+		dAtA9 := make([]byte, len(m.Slices)*10)
+		var j8 int
+		for _, num := range m.Slices {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
+	}
+	if m.Duration != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Duration))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Count))
+	}
+	if len(m.Children) > 0 {
+		for _, msg := range m.Children {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintPublic(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -909,11 +1649,11 @@ func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPublic(dAtA, i, uint64(m.Bitmap.Size()))
-		n5, err := m.Bitmap.MarshalTo(dAtA[i:])
+		n10, err := m.Bitmap.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n10
 	}
 	if m.N != 0 {
 		dAtA[i] = 0x10
@@ -946,16 +1686,48 @@ func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintPublic(dAtA, i, uint64(m.SumCount.Size()))
-		n6, err := m.SumCount.MarshalTo(dAtA[i:])
+		n11, err := m.SumCount.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n11
+	}
+	if m.ValCount != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.ValCount.Size()))
+		n12, err := m.ValCount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.GroupCounts) > 0 {
+		for _, msg := range m.GroupCounts {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintPublic(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Plan != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Plan.Size()))
+		n13, err := m.Plan.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
 	}
 	return i, nil
 }
 
-func (m *ImportRequest) Marshal() (dAtA []byte, err error) {
+func (m *CallPlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -965,16 +1737,64 @@ func (m *ImportRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImportRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CallPlan) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
+	if len(m.Call) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPublic(dAtA, i, uint64(len(m.Index)))
-		i += copy(dAtA[i:], m.Index)
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Call)))
+		i += copy(dAtA[i:], m.Call)
+	}
+	if len(m.Views) > 0 {
+		for _, msg := range m.Views {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPublic(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPublic(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ViewPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ViewPlan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Call) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Call)))
+		i += copy(dAtA[i:], m.Call)
 	}
 	if len(m.Frame) > 0 {
 		dAtA[i] = 0x12
@@ -982,67 +1802,113 @@ func (m *ImportRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPublic(dAtA, i, uint64(len(m.Frame)))
 		i += copy(dAtA[i:], m.Frame)
 	}
-	if m.Slice != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPublic(dAtA, i, uint64(m.Slice))
-	}
-	if len(m.RowIDs) > 0 {
-		dAtA8 := make([]byte, len(m.RowIDs)*10)
-		var j7 int
-		for _, num := range m.RowIDs {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
+	if len(m.Views) > 0 {
+		for _, s := range m.Views {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		dAtA[i] = 0x22
+	}
+	return i, nil
+}
+
+func (m *NodePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodePlan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Host) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPublic(dAtA, i, uint64(j7))
-		i += copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Host)))
+		i += copy(dAtA[i:], m.Host)
 	}
-	if len(m.ColumnIDs) > 0 {
-		dAtA10 := make([]byte, len(m.ColumnIDs)*10)
-		var j9 int
-		for _, num := range m.ColumnIDs {
+	if len(m.Slices) > 0 {
+		dAtA15 := make([]byte, len(m.Slices)*10)
+		var j14 int
+		for _, num := range m.Slices {
 			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j14++
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPublic(dAtA, i, uint64(j9))
-		i += copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintPublic(dAtA, i, uint64(j14))
+		i += copy(dAtA[i:], dAtA15[:j14])
 	}
-	if len(m.Timestamps) > 0 {
-		dAtA12 := make([]byte, len(m.Timestamps)*10)
-		var j11 int
-		for _, num1 := range m.Timestamps {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
+	if len(m.MissingFragments) > 0 {
+		for _, msg := range m.MissingFragments {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPublic(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			dAtA12[j11] = uint8(num)
-			j11++
+			i += n
 		}
-		dAtA[i] = 0x32
+	}
+	return i, nil
+}
+
+func (m *FragmentPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FragmentPlan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Frame) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Frame)))
+		i += copy(dAtA[i:], m.Frame)
+	}
+	if len(m.View) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.View)))
+		i += copy(dAtA[i:], m.View)
+	}
+	if m.Slice != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintPublic(dAtA, i, uint64(j11))
-		i += copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintPublic(dAtA, i, uint64(m.Slice))
 	}
 	return i, nil
 }
 
-func (m *ImportValueRequest) Marshal() (dAtA []byte, err error) {
+func (m *ImportRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1052,7 +1918,7 @@ func (m *ImportValueRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImportValueRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ImportRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1074,46 +1940,178 @@ func (m *ImportValueRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPublic(dAtA, i, uint64(m.Slice))
 	}
-	if len(m.Field) > 0 {
+	if len(m.RowIDs) > 0 {
+		dAtA17 := make([]byte, len(m.RowIDs)*10)
+		var j16 int
+		for _, num := range m.RowIDs {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPublic(dAtA, i, uint64(len(m.Field)))
-		i += copy(dAtA[i:], m.Field)
+		i = encodeVarintPublic(dAtA, i, uint64(j16))
+		i += copy(dAtA[i:], dAtA17[:j16])
 	}
 	if len(m.ColumnIDs) > 0 {
-		dAtA14 := make([]byte, len(m.ColumnIDs)*10)
-		var j13 int
+		dAtA19 := make([]byte, len(m.ColumnIDs)*10)
+		var j18 int
 		for _, num := range m.ColumnIDs {
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j18++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA19[j18] = uint8(num)
+			j18++
 		}
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPublic(dAtA, i, uint64(j13))
-		i += copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintPublic(dAtA, i, uint64(j18))
+		i += copy(dAtA[i:], dAtA19[:j18])
 	}
-	if len(m.Values) > 0 {
-		dAtA16 := make([]byte, len(m.Values)*10)
-		var j15 int
-		for _, num1 := range m.Values {
+	if len(m.Timestamps) > 0 {
+		dAtA21 := make([]byte, len(m.Timestamps)*10)
+		var j20 int
+		for _, num1 := range m.Timestamps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j20++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA21[j20] = uint8(num)
+			j20++
 		}
 		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPublic(dAtA, i, uint64(j15))
-		i += copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintPublic(dAtA, i, uint64(j20))
+		i += copy(dAtA[i:], dAtA21[:j20])
+	}
+	if len(m.RowKeys) > 0 {
+		for _, s := range m.RowKeys {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ColumnKeys) > 0 {
+		for _, s := range m.ColumnKeys {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ImportValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Index)))
+		i += copy(dAtA[i:], m.Index)
+	}
+	if len(m.Frame) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Frame)))
+		i += copy(dAtA[i:], m.Frame)
+	}
+	if m.Slice != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(m.Slice))
+	}
+	if len(m.Field) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if len(m.ColumnIDs) > 0 {
+		dAtA23 := make([]byte, len(m.ColumnIDs)*10)
+		var j22 int
+		for _, num := range m.ColumnIDs {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(j22))
+		i += copy(dAtA[i:], dAtA23[:j22])
+	}
+	if len(m.Values) > 0 {
+		dAtA25 := make([]byte, len(m.Values)*10)
+		var j24 int
+		for _, num1 := range m.Values {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPublic(dAtA, i, uint64(j24))
+		i += copy(dAtA[i:], dAtA25[:j24])
+	}
+	if len(m.DecimalValues) > 0 {
+		for _, s := range m.DecimalValues {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
@@ -1143,6 +2141,20 @@ func (m *Bitmap) Size() (n int) {
 			n += 1 + l + sovPublic(uint64(l))
 		}
 	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	l = len(m.Roaring)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
 	return n
 }
 
@@ -1155,6 +2167,10 @@ func (m *Pair) Size() (n int) {
 	if m.Count != 0 {
 		n += 1 + sovPublic(uint64(m.Count))
 	}
+	l = len(m.StringKey)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
 	return n
 }
 
@@ -1167,6 +2183,40 @@ func (m *SumCount) Size() (n int) {
 	if m.Count != 0 {
 		n += 1 + sovPublic(uint64(m.Count))
 	}
+	if m.Scale != 0 {
+		n += 1 + sovPublic(uint64(m.Scale))
+	}
+	return n
+}
+
+func (m *ValCount) Size() (n int) {
+	var l int
+	_ = l
+	if m.Val != 0 {
+		n += 1 + sovPublic(uint64(m.Val))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPublic(uint64(m.Count))
+	}
+	if m.Scale != 0 {
+		n += 1 + sovPublic(uint64(m.Scale))
+	}
+	return n
+}
+
+func (m *GroupCount) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		l = 0
+		for _, e := range m.Group {
+			l += sovPublic(uint64(e))
+		}
+		n += 1 + sovPublic(uint64(l)) + l
+	}
+	if m.Count != 0 {
+		n += 1 + sovPublic(uint64(m.Count))
+	}
 	return n
 }
 
@@ -1197,6 +2247,10 @@ func (m *ColumnAttrSet) Size() (n int) {
 			n += 1 + l + sovPublic(uint64(l))
 		}
 	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
 	return n
 }
 
@@ -1264,6 +2318,45 @@ func (m *QueryRequest) Size() (n int) {
 	if m.ExcludeBits {
 		n += 2
 	}
+	if m.Explain {
+		n += 2
+	}
+	if m.Profile {
+		n += 2
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovPublic(uint64(m.Timeout))
+	}
+	if len(m.AttrKeys) > 0 {
+		for _, s := range m.AttrKeys {
+			l = len(s)
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
+	if m.ColumnAttrsLimit != 0 {
+		n += 1 + sovPublic(uint64(m.ColumnAttrsLimit))
+	}
+	if m.ColumnAttrsOffset != 0 {
+		n += 1 + sovPublic(uint64(m.ColumnAttrsOffset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPublic(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPublic(uint64(m.Offset))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 2 + l + sovPublic(uint64(l))
+	}
+	l = len(m.BitmapFormat)
+	if l > 0 {
+		n += 2 + l + sovPublic(uint64(l))
+	}
+	l = len(m.QueryID)
+	if l > 0 {
+		n += 2 + l + sovPublic(uint64(l))
+	}
 	return n
 }
 
@@ -1286,6 +2379,47 @@ func (m *QueryResponse) Size() (n int) {
 			n += 1 + l + sovPublic(uint64(l))
 		}
 	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	return n
+}
+
+func (m *Profile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	l = len(m.Call)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	if len(m.Slices) > 0 {
+		l = 0
+		for _, e := range m.Slices {
+			l += sovPublic(uint64(e))
+		}
+		n += 1 + sovPublic(uint64(l)) + l
+	}
+	if m.Duration != 0 {
+		n += 1 + sovPublic(uint64(m.Duration))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPublic(uint64(m.Count))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1312,6 +2446,102 @@ func (m *QueryResult) Size() (n int) {
 		l = m.SumCount.Size()
 		n += 1 + l + sovPublic(uint64(l))
 	}
+	if m.ValCount != nil {
+		l = m.ValCount.Size()
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	if len(m.GroupCounts) > 0 {
+		for _, e := range m.GroupCounts {
+			l = e.Size()
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	return n
+}
+
+func (m *CallPlan) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Call)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	if len(m.Views) > 0 {
+		for _, e := range m.Views {
+			l = e.Size()
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ViewPlan) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Call)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	l = len(m.Frame)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	if len(m.Views) > 0 {
+		for _, s := range m.Views {
+			l = len(s)
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodePlan) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	if len(m.Slices) > 0 {
+		l = 0
+		for _, e := range m.Slices {
+			l += sovPublic(uint64(e))
+		}
+		n += 1 + sovPublic(uint64(l)) + l
+	}
+	if len(m.MissingFragments) > 0 {
+		for _, e := range m.MissingFragments {
+			l = e.Size()
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FragmentPlan) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Frame)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	l = len(m.View)
+	if l > 0 {
+		n += 1 + l + sovPublic(uint64(l))
+	}
+	if m.Slice != 0 {
+		n += 1 + sovPublic(uint64(m.Slice))
+	}
 	return n
 }
 
@@ -1350,6 +2580,18 @@ func (m *ImportRequest) Size() (n int) {
 		}
 		n += 1 + sovPublic(uint64(l)) + l
 	}
+	if len(m.RowKeys) > 0 {
+		for _, s := range m.RowKeys {
+			l = len(s)
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
+	if len(m.ColumnKeys) > 0 {
+		for _, s := range m.ColumnKeys {
+			l = len(s)
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1385,6 +2627,12 @@ func (m *ImportValueRequest) Size() (n int) {
 		}
 		n += 1 + sovPublic(uint64(l)) + l
 	}
+	if len(m.DecimalValues) > 0 {
+		for _, s := range m.DecimalValues {
+			l = len(s)
+			n += 1 + l + sovPublic(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1523,14 +2771,103 @@ func (m *Bitmap) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPublic(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPublic
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roaring", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roaring = append(m.Roaring[:0], dAtA[iNdEx:postIndex]...)
+			if m.Roaring == nil {
+				m.Roaring = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublic
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -1611,6 +2948,35 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StringKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPublic(dAtA[iNdEx:])
@@ -1699,6 +3065,25 @@ func (m *SumCount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			m.Scale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scale |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPublic(dAtA[iNdEx:])
@@ -1720,7 +3105,7 @@ func (m *SumCount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bit) Unmarshal(dAtA []byte) error {
+func (m *ValCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1743,17 +3128,17 @@ func (m *Bit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bit: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			m.RowID = 0
+			m.Val = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -1763,16 +3148,16 @@ func (m *Bit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RowID |= (uint64(b) & 0x7F) << shift
+				m.Val |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.ColumnID = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -1782,16 +3167,16 @@ func (m *Bit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ColumnID |= (uint64(b) & 0x7F) << shift
+				m.Count |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
 			}
-			m.Timestamp = 0
+			m.Scale = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -1801,7 +3186,7 @@ func (m *Bit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= (int64(b) & 0x7F) << shift
+				m.Scale |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1827,7 +3212,7 @@ func (m *Bit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ColumnAttrSet) Unmarshal(dAtA []byte) error {
+func (m *GroupCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1850,36 +3235,79 @@ func (m *ColumnAttrSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ColumnAttrSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ColumnAttrSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPublic
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPublic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Group = append(m.Group, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPublic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPublic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPublic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Group = append(m.Group, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -1889,23 +3317,11 @@ func (m *ColumnAttrSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Count |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPublic
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attrs = append(m.Attrs, &Attr{})
-			if err := m.Attrs[len(m.Attrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPublic(dAtA[iNdEx:])
@@ -1927,7 +3343,7 @@ func (m *ColumnAttrSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attr) Unmarshal(dAtA []byte) error {
+func (m *Bit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1950,17 +3366,17 @@ func (m *Attr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attr: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowID", wireType)
 			}
-			var stringLen uint64
+			m.RowID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -1970,24 +3386,260 @@ func (m *Attr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.RowID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPublic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnID", wireType)
+			}
+			m.ColumnID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ColumnID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColumnAttrSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColumnAttrSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColumnAttrSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attrs = append(m.Attrs, &Attr{})
+			if err := m.Attrs[len(m.Attrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
@@ -2056,7 +3708,1283 @@ func (m *Attr) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
 			}
-			var v int
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BoolValue = bool(v != 0)
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FloatValue = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttrMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttrMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttrMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attrs = append(m.Attrs, &Attr{})
+			if err := m.Attrs[len(m.Attrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPublic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Slices = append(m.Slices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPublic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPublic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPublic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Slices = append(m.Slices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnAttrs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnAttrs = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remote = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeAttrs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeAttrs = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeBits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeBits = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Explain = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Profile = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttrKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttrKeys = append(m.AttrKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnAttrsLimit", wireType)
+			}
+			m.ColumnAttrsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ColumnAttrsLimit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnAttrsOffset", wireType)
+			}
+			m.ColumnAttrsOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ColumnAttrsOffset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitmapFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BitmapFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &QueryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnAttrSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnAttrSets = append(m.ColumnAttrSets, &ColumnAttrSet{})
+			if err := m.ColumnAttrSets[len(m.ColumnAttrSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &Profile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Profile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Call = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPublic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Slices = append(m.Slices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPublic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPublic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPublic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Slices = append(m.Slices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &Profile{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPublic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPublic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPublic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bitmap == nil {
+				m.Bitmap = &Bitmap{}
+			}
+			if err := m.Bitmap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			}
+			m.N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.N |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &Pair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Changed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SumCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SumCount == nil {
+				m.SumCount = &SumCount{}
+			}
+			if err := m.SumCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValCount == nil {
+				m.ValCount = &ValCount{}
+			}
+			if err := m.ValCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupCounts = append(m.GroupCounts, &GroupCount{})
+			if err := m.GroupCounts[len(m.GroupCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -2066,23 +4994,25 @@ func (m *Attr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BoolValue = bool(v != 0)
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FloatValue = float64(math.Float64frombits(v))
+			if m.Plan == nil {
+				m.Plan = &CallPlan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPublic(dAtA[iNdEx:])
@@ -2104,7 +5034,7 @@ func (m *Attr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttrMap) Unmarshal(dAtA []byte) error {
+func (m *CallPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2127,15 +5057,44 @@ func (m *AttrMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttrMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttrMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Call = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2159,8 +5118,39 @@ func (m *AttrMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attrs = append(m.Attrs, &Attr{})
-			if err := m.Attrs[len(m.Attrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Views = append(m.Views, &ViewPlan{})
+			if err := m.Views[len(m.Views)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodePlan{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2185,7 +5175,7 @@ func (m *AttrMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+func (m *ViewPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2208,15 +5198,15 @@ func (m *QueryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ViewPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ViewPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2241,75 +5231,13 @@ func (m *QueryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Call = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPublic
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Slices = append(m.Slices, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPublic
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPublic
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPublic
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Slices = append(m.Slices, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnAttrs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -2319,37 +5247,26 @@ func (m *QueryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ColumnAttrs = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPublic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Remote = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeAttrs", wireType)
+			m.Frame = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -2359,32 +5276,21 @@ func (m *QueryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeAttrs = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeBits", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPublic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ExcludeBits = bool(v != 0)
+			m.Views = append(m.Views, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPublic(dAtA[iNdEx:])
@@ -2406,7 +5312,7 @@ func (m *QueryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResponse) Unmarshal(dAtA []byte) error {
+func (m *NodePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2429,46 +5335,17 @@ func (m *QueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPublic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPublic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Err = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -2478,26 +5355,86 @@ func (m *QueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPublic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, &QueryResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPublic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Slices = append(m.Slices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPublic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPublic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPublic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Slices = append(m.Slices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnAttrSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingFragments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2521,8 +5458,8 @@ func (m *QueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ColumnAttrSets = append(m.ColumnAttrSets, &ColumnAttrSet{})
-			if err := m.ColumnAttrSets[len(m.ColumnAttrSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MissingFragments = append(m.MissingFragments, &FragmentPlan{})
+			if err := m.MissingFragments[len(m.MissingFragments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2547,7 +5484,7 @@ func (m *QueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResult) Unmarshal(dAtA []byte) error {
+func (m *FragmentPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2570,17 +5507,17 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: FragmentPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FragmentPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -2590,49 +5527,26 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPublic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Bitmap == nil {
-				m.Bitmap = &Bitmap{}
-			}
-			if err := m.Bitmap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Frame = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
-			}
-			m.N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPublic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.N |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -2642,48 +5556,26 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPublic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, &Pair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.View = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPublic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Changed = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SumCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slice", wireType)
 			}
-			var msglen int
+			m.Slice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPublic
@@ -2693,25 +5585,11 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Slice |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPublic
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SumCount == nil {
-				m.SumCount = &SumCount{}
-			}
-			if err := m.SumCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPublic(dAtA[iNdEx:])
@@ -3025,6 +5903,64 @@ func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowKeys = append(m.RowKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnKeys = append(m.ColumnKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPublic(dAtA[iNdEx:])
@@ -3305,6 +6241,35 @@ func (m *ImportValueRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPublic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPublic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecimalValues = append(m.DecimalValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPublic(dAtA[iNdEx:])
@@ -3434,46 +6399,78 @@ var (
 func init() { proto.RegisterFile("public.proto", fileDescriptorPublic) }
 
 var fileDescriptorPublic = []byte{
-	// 651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x65, 0x62, 0xe7, 0x75, 0x93, 0x56, 0xd5, 0x08, 0x8a, 0x85, 0x50, 0x14, 0x59, 0x2c, 0xbc,
-	0x4a, 0xa5, 0xf0, 0x01, 0x08, 0xb7, 0xa9, 0x64, 0x21, 0x2a, 0x98, 0x14, 0xf6, 0x6e, 0x3b, 0x2a,
-	0x96, 0xfc, 0x62, 0x3c, 0x16, 0xed, 0x77, 0xb0, 0x61, 0xcd, 0x06, 0x7e, 0x80, 0x1d, 0x1f, 0xc0,
-	0x92, 0x4f, 0x40, 0xe1, 0x47, 0xd0, 0xbd, 0xe3, 0x89, 0x1d, 0x16, 0xc0, 0x82, 0xdd, 0x9c, 0x73,
-	0x1f, 0xbe, 0x8f, 0x73, 0x0d, 0xd3, 0xb2, 0xbe, 0x48, 0x93, 0xcb, 0x45, 0xa9, 0x0a, 0x5d, 0xf0,
-	0x51, 0x92, 0x6b, 0xa9, 0xf2, 0x38, 0xf5, 0x43, 0x18, 0x84, 0x89, 0xce, 0xe2, 0x92, 0x73, 0x70,
-	0xc3, 0x44, 0x57, 0x1e, 0x9b, 0x3b, 0x81, 0x2b, 0xe8, 0xcd, 0x1f, 0x41, 0xff, 0xa9, 0xd6, 0xaa,
-	0xf2, 0x7a, 0x73, 0x27, 0x98, 0x2c, 0xf7, 0x17, 0x36, 0x6e, 0x81, 0xb4, 0x30, 0x46, 0x7f, 0x01,
-	0xee, 0x8b, 0x38, 0x51, 0xfc, 0x00, 0x9c, 0x67, 0xf2, 0xd6, 0x63, 0x73, 0x16, 0xb8, 0x02, 0x9f,
-	0xfc, 0x2e, 0xf4, 0x8f, 0x8b, 0x3a, 0xd7, 0x5e, 0x8f, 0x38, 0x03, 0xfc, 0x25, 0x8c, 0xd6, 0x75,
-	0x46, 0x6f, 0x8c, 0x59, 0xd7, 0x19, 0xc5, 0x38, 0x02, 0x9f, 0xbb, 0x31, 0x8e, 0x8d, 0x79, 0x05,
-	0x4e, 0x98, 0x68, 0x34, 0x8a, 0xe2, 0x5d, 0x74, 0xd2, 0x7c, 0xc4, 0x00, 0xfe, 0x00, 0x46, 0xc7,
-	0x45, 0x5a, 0x67, 0x79, 0x74, 0xd2, 0x7c, 0x69, 0x8b, 0xf9, 0x43, 0x18, 0x9f, 0x27, 0x99, 0xac,
-	0x74, 0x9c, 0x95, 0x9e, 0x43, 0x29, 0x5b, 0xc2, 0x5f, 0xc1, 0x9e, 0xf1, 0xc4, 0x4e, 0xd6, 0x52,
-	0xf3, 0x7d, 0xe8, 0x6d, 0xb3, 0xf7, 0xa2, 0x93, 0x7f, 0x9c, 0xc0, 0x67, 0x06, 0x2e, 0xbe, 0xba,
-	0x23, 0x18, 0x9b, 0x11, 0x70, 0x70, 0xcf, 0x6f, 0x4b, 0xd9, 0xd4, 0x45, 0x6f, 0x3e, 0x87, 0xc9,
-	0x5a, 0xab, 0x24, 0xbf, 0x7e, 0x1d, 0xa7, 0xb5, 0xa4, 0xaa, 0xc6, 0xa2, 0x4b, 0x61, 0x47, 0x51,
-	0xae, 0x8d, 0xd9, 0xa5, 0xa2, 0xb7, 0x18, 0x3b, 0x0a, 0x8b, 0x22, 0x35, 0xc6, 0xfe, 0x9c, 0x05,
-	0x23, 0xd1, 0x12, 0x7c, 0x06, 0x70, 0x9a, 0x16, 0x71, 0x13, 0x3b, 0x98, 0xb3, 0x80, 0x89, 0x0e,
-	0xe3, 0x1f, 0xc1, 0x10, 0x2b, 0x7d, 0x1e, 0x97, 0x6d, 0x6f, 0xec, 0x4f, 0xbd, 0x7d, 0x65, 0x30,
-	0x7d, 0x59, 0x4b, 0x75, 0x2b, 0xe4, 0xdb, 0x5a, 0x56, 0xb4, 0x03, 0xc2, 0x4d, 0x97, 0x06, 0xf0,
-	0x43, 0x18, 0xac, 0xd3, 0xe4, 0x52, 0x9a, 0x49, 0xb9, 0xa2, 0x41, 0xd8, 0x6b, 0x3b, 0xe1, 0x8a,
-	0x7a, 0x1d, 0x89, 0x2e, 0x85, 0x91, 0x42, 0x66, 0x85, 0xb6, 0xcd, 0x34, 0x88, 0xfb, 0x30, 0x5d,
-	0xdd, 0x5c, 0xa6, 0xf5, 0x95, 0x34, 0xa1, 0x03, 0xb2, 0xee, 0x70, 0x98, 0xbd, 0xc1, 0xa4, 0xdd,
-	0xa1, 0xc9, 0xde, 0xa1, 0xfc, 0xf7, 0x0c, 0xf6, 0x9a, 0xf2, 0xab, 0xb2, 0xc8, 0x2b, 0x89, 0x3b,
-	0x5a, 0x29, 0x65, 0x77, 0xb4, 0x52, 0x8a, 0x1f, 0xc1, 0x50, 0xc8, 0xaa, 0x4e, 0xb5, 0x5d, 0xf3,
-	0xbd, 0x76, 0x14, 0x36, 0xb6, 0x4e, 0xb5, 0xb0, 0x5e, 0xfc, 0x09, 0xec, 0xef, 0xc8, 0x06, 0xfb,
-	0xc2, 0xb8, 0xfb, 0x6d, 0xdc, 0x8e, 0x5d, 0xfc, 0xe6, 0xee, 0x7f, 0x61, 0x30, 0xe9, 0x64, 0xe6,
-	0x81, 0x3d, 0x43, 0x2a, 0x6b, 0xb2, 0x3c, 0x68, 0x13, 0x19, 0x5e, 0xd8, 0x33, 0x9d, 0x02, 0x3b,
-	0x6b, 0xc4, 0xc4, 0xce, 0x70, 0x85, 0x78, 0x7a, 0xf6, 0xfb, 0x9d, 0x15, 0x22, 0x2d, 0x8c, 0x91,
-	0x7b, 0x30, 0x3c, 0x7e, 0x13, 0xe7, 0xd7, 0xf2, 0x8a, 0xc4, 0x34, 0x12, 0x16, 0xf2, 0x45, 0x7b,
-	0x8a, 0x34, 0xfd, 0xc9, 0x92, 0xb7, 0x29, 0xac, 0x45, 0x6c, 0x7d, 0xfc, 0x4f, 0x0c, 0xf6, 0xa2,
-	0xac, 0x2c, 0x94, 0xee, 0xa8, 0x21, 0xca, 0xaf, 0xe4, 0x8d, 0x55, 0x03, 0x01, 0x64, 0x4f, 0x55,
-	0x9c, 0x19, 0xd9, 0x8f, 0x85, 0x01, 0xc8, 0x92, 0x2a, 0x48, 0x05, 0xae, 0x30, 0x80, 0xf6, 0x8f,
-	0x67, 0x5c, 0x79, 0xae, 0x51, 0x8e, 0x41, 0xa8, 0x73, 0x7b, 0xc5, 0x95, 0xd7, 0x27, 0x53, 0x4b,
-	0xa0, 0xce, 0xb7, 0x67, 0x8c, 0xda, 0x70, 0x02, 0x47, 0x74, 0x18, 0xff, 0x23, 0x03, 0x6e, 0x2a,
-	0x25, 0xdd, 0xff, 0xbf, 0x72, 0xd1, 0x37, 0x91, 0xa9, 0x19, 0x25, 0xfa, 0x22, 0xf8, 0x4b, 0xb1,
-	0x87, 0x30, 0xa0, 0x2a, 0x6c, 0xa1, 0x0d, 0x0a, 0x0f, 0xbe, 0x6d, 0x66, 0xec, 0xfb, 0x66, 0xc6,
-	0x7e, 0x6c, 0x66, 0xec, 0xc3, 0xcf, 0xd9, 0x9d, 0x8b, 0x01, 0xfd, 0xa0, 0x1f, 0xff, 0x0a, 0x00,
-	0x00, 0xff, 0xff, 0x4d, 0x1e, 0xdf, 0xba, 0xb0, 0x05, 0x00, 0x00,
+	// 1156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdb, 0x6e, 0x23, 0x45,
+	0x13, 0xfe, 0x3b, 0x33, 0xb6, 0x27, 0x65, 0x3b, 0xbf, 0xb7, 0x15, 0xc2, 0x68, 0x85, 0x22, 0x6b,
+	0xb4, 0x42, 0x16, 0x87, 0xac, 0x14, 0x24, 0xc4, 0x1d, 0xc2, 0x76, 0xc2, 0x9a, 0xdd, 0x0d, 0x4b,
+	0x7b, 0x09, 0xd7, 0xb3, 0x71, 0x27, 0x3b, 0x52, 0xcf, 0x8c, 0x99, 0x83, 0x92, 0x70, 0xcd, 0x05,
+	0x8f, 0xc0, 0x23, 0xf0, 0x14, 0x88, 0x1b, 0xa4, 0xbd, 0xe4, 0x11, 0x20, 0xbc, 0x08, 0xaa, 0xea,
+	0xee, 0x99, 0xf6, 0x26, 0x9c, 0x24, 0xee, 0xfa, 0xab, 0xaf, 0xaa, 0xa6, 0x8e, 0xdd, 0x03, 0x83,
+	0x75, 0xfd, 0x42, 0x25, 0x67, 0x07, 0xeb, 0x22, 0xaf, 0x72, 0x1e, 0x24, 0x59, 0x25, 0x8b, 0x2c,
+	0x56, 0xd1, 0x77, 0x0c, 0xba, 0xd3, 0xa4, 0x4a, 0xe3, 0x35, 0xe7, 0xe0, 0x4f, 0x93, 0xaa, 0x0c,
+	0xd9, 0xd8, 0x9b, 0xf8, 0x82, 0xce, 0xfc, 0x01, 0x74, 0x3e, 0xa9, 0xaa, 0xa2, 0x0c, 0xb7, 0xc6,
+	0xde, 0xa4, 0x7f, 0xb8, 0x73, 0x60, 0x0d, 0x0f, 0x50, 0x2c, 0x34, 0x89, 0x96, 0x8f, 0xe5, 0x75,
+	0x19, 0x7a, 0x63, 0x6f, 0xb2, 0x2d, 0xe8, 0xcc, 0xf7, 0xa0, 0x3b, 0xab, 0x8b, 0x32, 0x2f, 0x42,
+	0x7f, 0xcc, 0x26, 0xdb, 0xc2, 0x20, 0x1e, 0x42, 0x4f, 0xe4, 0x71, 0x91, 0x64, 0x17, 0x61, 0x67,
+	0xcc, 0x26, 0x03, 0x61, 0x61, 0xf4, 0x04, 0xfc, 0x67, 0x71, 0x52, 0xf0, 0x11, 0x78, 0x8f, 0xe5,
+	0x75, 0xc8, 0xc6, 0x6c, 0xe2, 0x0b, 0x3c, 0xf2, 0x5d, 0xe8, 0xcc, 0xf2, 0x3a, 0xab, 0xc2, 0x2d,
+	0x92, 0x69, 0xc0, 0xdf, 0x82, 0xed, 0x65, 0x85, 0x96, 0xa8, 0xed, 0xd1, 0x47, 0x5a, 0x41, 0xf4,
+	0x08, 0x82, 0x65, 0x9d, 0x6a, 0xcd, 0x11, 0x78, 0xcb, 0x3a, 0x25, 0x8f, 0x9e, 0xc0, 0xe3, 0xa6,
+	0x47, 0xcf, 0x7a, 0xdc, 0x85, 0xce, 0xf2, 0x2c, 0x56, 0x92, 0xbc, 0x0d, 0x85, 0x06, 0xe8, 0xe9,
+	0x34, 0x56, 0x8d, 0xa7, 0xd3, 0x58, 0x59, 0x4f, 0xa7, 0xb1, 0xfa, 0x57, 0x9e, 0x3e, 0x02, 0xf8,
+	0xb4, 0xc8, 0xeb, 0x75, 0xa3, 0x43, 0xc8, 0x14, 0x5c, 0x83, 0xbb, 0x73, 0x8d, 0xbe, 0x04, 0x6f,
+	0x9a, 0x90, 0x89, 0xc8, 0x2f, 0x17, 0x73, 0x53, 0x1c, 0x0d, 0xf8, 0x7d, 0x08, 0x66, 0xb9, 0xaa,
+	0xd3, 0x6c, 0x31, 0x37, 0x56, 0x0d, 0xc6, 0x22, 0x3d, 0x4f, 0x52, 0x59, 0x56, 0x71, 0xba, 0xa6,
+	0x60, 0x3c, 0xd1, 0x0a, 0xa2, 0xaf, 0x60, 0xa8, 0x35, 0xb1, 0x8f, 0x4b, 0x59, 0xf1, 0x1d, 0xd8,
+	0x6a, 0xbc, 0x6f, 0x2d, 0xe6, 0xff, 0xb0, 0xff, 0xa6, 0x63, 0xba, 0x07, 0x78, 0x8c, 0x7e, 0x60,
+	0xe0, 0x23, 0xe7, 0x36, 0x53, 0x53, 0x38, 0x2c, 0xcf, 0xaf, 0xd7, 0xd2, 0x44, 0x4a, 0x67, 0x3e,
+	0x86, 0xbe, 0xee, 0xdc, 0x69, 0xac, 0x6a, 0x69, 0x1c, 0xb9, 0x22, 0xcc, 0x71, 0x91, 0x55, 0x9a,
+	0xf6, 0x29, 0x8d, 0x06, 0x63, 0x8e, 0xd3, 0x3c, 0x57, 0x9a, 0xc4, 0xa1, 0x0a, 0x44, 0x2b, 0xe0,
+	0xfb, 0x00, 0xc7, 0x2a, 0x8f, 0x8d, 0x6d, 0x77, 0xcc, 0x26, 0x4c, 0x38, 0x92, 0xe8, 0x21, 0xf4,
+	0x30, 0xd2, 0xa7, 0xf1, 0xba, 0xcd, 0x96, 0xfd, 0x45, 0xb6, 0xd1, 0xb7, 0x3e, 0x0c, 0xbe, 0xa8,
+	0x65, 0x71, 0x2d, 0xe4, 0xd7, 0xb5, 0x2c, 0xa9, 0x2b, 0x84, 0x4d, 0x96, 0x1a, 0xe0, 0x02, 0x2c,
+	0x55, 0x72, 0x26, 0x75, 0xed, 0x7c, 0x61, 0x10, 0xe6, 0xda, 0xd6, 0xbc, 0xa4, 0x5c, 0x03, 0xe1,
+	0x8a, 0xd0, 0x52, 0xc8, 0x34, 0xaf, 0x6c, 0x32, 0x06, 0xf1, 0x08, 0x06, 0x47, 0x57, 0x67, 0xaa,
+	0x5e, 0x49, 0x6d, 0xda, 0x25, 0x76, 0x43, 0x86, 0xde, 0x0d, 0xa6, 0x5d, 0xee, 0x69, 0xef, 0x8e,
+	0x08, 0x17, 0xf0, 0xe8, 0x6a, 0xad, 0xe2, 0x24, 0x0b, 0x03, 0x62, 0x2d, 0x44, 0xe6, 0x59, 0x91,
+	0x9f, 0x27, 0x4a, 0x86, 0xdb, 0x9a, 0x31, 0x10, 0x19, 0x1c, 0x9a, 0xbc, 0xae, 0x42, 0xa0, 0xe2,
+	0x5b, 0x88, 0x7d, 0xc1, 0x0f, 0xd3, 0xfa, 0xf7, 0x69, 0xfd, 0x1b, 0xcc, 0xdf, 0x81, 0x91, 0x93,
+	0xd6, 0x93, 0x24, 0x4d, 0xaa, 0x70, 0x40, 0x5d, 0xbf, 0x25, 0xe7, 0xef, 0xc1, 0x3d, 0x47, 0xf6,
+	0xf9, 0xf9, 0x79, 0x29, 0xab, 0x70, 0x48, 0xca, 0xb7, 0x09, 0xac, 0xb8, 0x76, 0xb7, 0xa3, 0xf7,
+	0x40, 0xfb, 0xd8, 0x83, 0xae, 0x31, 0xfc, 0x3f, 0x89, 0x0d, 0x72, 0xae, 0xa2, 0xd1, 0xc6, 0x55,
+	0x14, 0xc1, 0x40, 0x5f, 0x7d, 0xc7, 0x79, 0x91, 0xc6, 0x55, 0x78, 0x8f, 0xd8, 0x0d, 0x19, 0x66,
+	0x4e, 0xed, 0x5c, 0xcc, 0x43, 0x4e, 0xb4, 0x85, 0xd1, 0x4f, 0x0c, 0x86, 0x66, 0x0c, 0xca, 0x75,
+	0x9e, 0x95, 0x12, 0x67, 0xfd, 0xa8, 0x28, 0xec, 0xac, 0x1f, 0x15, 0x05, 0x7f, 0x08, 0x3d, 0x21,
+	0xcb, 0x5a, 0x55, 0x76, 0x81, 0xde, 0x68, 0x47, 0xca, 0xda, 0xd6, 0xaa, 0x12, 0x56, 0x8b, 0x7f,
+	0x0c, 0x3b, 0x1b, 0x0b, 0xa9, 0xef, 0xd4, 0xfe, 0xe1, 0x9b, 0xad, 0xdd, 0x06, 0x2f, 0x5e, 0x53,
+	0xe7, 0xef, 0xb6, 0x3d, 0xc4, 0x35, 0xe9, 0x1f, 0xde, 0x6b, 0x2d, 0x0d, 0xd1, 0xb4, 0x35, 0xfa,
+	0x91, 0x35, 0xda, 0xb8, 0x96, 0x27, 0x71, 0x2a, 0x4d, 0xf4, 0x74, 0x46, 0xd9, 0x2c, 0x56, 0x8a,
+	0x56, 0x75, 0x5b, 0xd0, 0x19, 0x65, 0x8f, 0xf2, 0xb2, 0x32, 0x3b, 0x4a, 0x67, 0x67, 0xd4, 0xfd,
+	0x8d, 0x51, 0xbf, 0x0f, 0xc1, 0xbc, 0x2e, 0xe2, 0x2a, 0xc9, 0x33, 0x1a, 0x65, 0x4f, 0x34, 0xb8,
+	0xbd, 0xe7, 0xba, 0xee, 0x9d, 0xfe, 0x3e, 0x04, 0xb3, 0x97, 0x89, 0x5a, 0x15, 0x32, 0x0b, 0x7b,
+	0x63, 0xef, 0xee, 0xf8, 0x1b, 0x95, 0xe8, 0xe7, 0x2d, 0xe8, 0x3b, 0x75, 0xe4, 0x13, 0xfb, 0x98,
+	0x51, 0x1a, 0xfd, 0xc3, 0x51, 0x6b, 0xac, 0xe5, 0xc2, 0xf0, 0x7c, 0x00, 0xec, 0xc4, 0x5c, 0x41,
+	0xec, 0x04, 0x17, 0x1f, 0x9f, 0x1e, 0x5b, 0x6d, 0x67, 0xf1, 0x51, 0x2c, 0x34, 0x89, 0xb3, 0x30,
+	0x7b, 0x19, 0x67, 0x17, 0x72, 0x45, 0xb5, 0x0d, 0x84, 0x85, 0xfc, 0xa0, 0x7d, 0x6c, 0x28, 0xd1,
+	0xfe, 0x21, 0x6f, 0x5d, 0x58, 0x46, 0x34, 0x3a, 0xfc, 0xa0, 0x7d, 0x52, 0xc2, 0xee, 0xeb, 0xfa,
+	0x96, 0x11, 0x8d, 0x0e, 0xff, 0x10, 0xfa, 0xed, 0xc3, 0x51, 0x9a, 0xca, 0xec, 0xb6, 0x26, 0x2d,
+	0x29, 0x5c, 0x45, 0xfe, 0x36, 0xf8, 0xcf, 0x54, 0xac, 0x17, 0x7d, 0xe3, 0x1b, 0xd8, 0x4a, 0x64,
+	0x04, 0xf1, 0x51, 0x01, 0x81, 0x95, 0x34, 0x4d, 0x67, 0x4e, 0xd3, 0x27, 0xd0, 0x39, 0x4d, 0xe4,
+	0xa5, 0x9d, 0x62, 0x37, 0xd8, 0x44, 0x5e, 0x92, 0x23, 0xad, 0x80, 0x9a, 0x27, 0xf9, 0x4a, 0xda,
+	0x4a, 0x3a, 0x9a, 0x28, 0xd6, 0x9a, 0xa4, 0x10, 0x7d, 0x06, 0x81, 0x35, 0xbe, 0xf3, 0x9b, 0xbb,
+	0xd0, 0x39, 0x2e, 0x70, 0x22, 0xf5, 0xf4, 0x69, 0x80, 0x52, 0x1d, 0x89, 0xfe, 0xd7, 0xd0, 0x20,
+	0xfa, 0x06, 0x02, 0xeb, 0xbe, 0x19, 0x50, 0x76, 0xe7, 0x80, 0x6e, 0xde, 0xc5, 0x53, 0x18, 0x3d,
+	0x4d, 0xca, 0x32, 0xc9, 0x2e, 0x8e, 0x8b, 0xf8, 0x22, 0x95, 0x59, 0xb3, 0x70, 0x7b, 0x6d, 0xe0,
+	0x96, 0xa2, 0xe0, 0x6f, 0xe9, 0x47, 0x27, 0x30, 0x70, 0x35, 0xda, 0xb8, 0x99, 0x1b, 0x37, 0x07,
+	0x1f, 0x43, 0xb5, 0xab, 0x84, 0x67, 0xfa, 0x49, 0xc0, 0x38, 0x68, 0x97, 0x7c, 0xa1, 0x41, 0xf4,
+	0x1b, 0x83, 0xe1, 0x22, 0x5d, 0xe7, 0x45, 0xe5, 0xbc, 0x2f, 0x8b, 0x6c, 0x25, 0xaf, 0xac, 0x47,
+	0x02, 0x7f, 0x5e, 0x9f, 0xdb, 0x3e, 0xe9, 0x45, 0xc1, 0x5f, 0x85, 0x66, 0x41, 0x35, 0xc2, 0x97,
+	0xd3, 0xfe, 0x29, 0x94, 0x61, 0x87, 0xa8, 0x56, 0x80, 0x2f, 0x67, 0xf3, 0xab, 0x80, 0xaf, 0x8d,
+	0x37, 0xf1, 0x84, 0x23, 0xd1, 0xbf, 0x72, 0x97, 0x74, 0xf5, 0xf7, 0xa8, 0x1b, 0x16, 0xa2, 0xa5,
+	0x76, 0x43, 0x64, 0x40, 0xa4, 0x23, 0x89, 0x5e, 0x31, 0xe0, 0x3a, 0x47, 0x7a, 0x83, 0xff, 0xbb,
+	0x44, 0x51, 0x37, 0x91, 0x6a, 0x65, 0x7e, 0x3a, 0x35, 0xf8, 0x9b, 0x34, 0xf7, 0xa0, 0x4b, 0x51,
+	0xd8, 0x14, 0x0d, 0xe2, 0x0f, 0x60, 0x38, 0x97, 0x67, 0x49, 0x1a, 0x2b, 0x43, 0xeb, 0x24, 0x37,
+	0x85, 0xd3, 0xd1, 0xab, 0x9b, 0x7d, 0xf6, 0xcb, 0xcd, 0x3e, 0xfb, 0xf5, 0x66, 0x9f, 0x7d, 0xff,
+	0xfb, 0xfe, 0xff, 0x5e, 0x74, 0xe9, 0x1f, 0xfb, 0x83, 0x3f, 0x06, 0x00, 0x5f, 0x4b, 0x0e, 0x40,
+	0x73, 0x0b, 0x00, 0x00,
 }
@@ -38,6 +38,12 @@ var (
 	ErrFrameInverseDisabled = errors.New("frame inverse disabled")
 	ErrColumnRowLabelEqual  = errors.New("column and row labels cannot be equal")
 
+	ErrIndexKeysNotEnabled = errors.New("index keys not enabled")
+	ErrFrameKeysNotEnabled = errors.New("frame keys not enabled")
+
+	ErrTranslatorNotConfigured = errors.New("translator not configured")
+	ErrTranslateKeyNotFound    = errors.New("translate key not found")
+
 	ErrInputDefinitionExists         = errors.New("input-definition already exists")
 	ErrInputDefinitionHasPrimaryKey  = errors.New("input-definition must contain one PrimaryKey")
 	ErrInputDefinitionDupePrimaryKey = errors.New("input-definition can only contain one PrimaryKey")
@@ -53,6 +59,9 @@ var (
 	ErrFieldNameRequired      = errors.New("field name required")
 	ErrInvalidFieldType       = errors.New("invalid field type")
 	ErrInvalidFieldRange      = errors.New("invalid field range")
+	ErrInvalidFieldScale      = errors.New("invalid field scale")
+	ErrInvalidFieldUnit       = errors.New("invalid field unit")
+	ErrFieldValuePrecision    = errors.New("field value exceeds field precision")
 	ErrInverseRangeNotAllowed = errors.New("inverse range not allowed")
 	ErrRangeCacheNotAllowed   = errors.New("range cache not allowed")
 	ErrFrameFieldsNotAllowed  = errors.New("frame fields not allowed")
@@ -62,8 +71,18 @@ var (
 	ErrInvalidRangeOperation  = errors.New("invalid range operation")
 	ErrInvalidBetweenValue    = errors.New("invalid value for between operation")
 
-	ErrInvalidView      = errors.New("invalid view")
-	ErrInvalidCacheType = errors.New("invalid cache type")
+	ErrAttrKeyRequired   = errors.New("attribute key required")
+	ErrAttrValueRequired = errors.New("attribute value required")
+
+	ErrInvalidView          = errors.New("invalid view")
+	ErrInvalidCacheType     = errors.New("invalid cache type")
+	ErrInvalidFsyncPolicy   = errors.New("invalid fsync policy")
+	ErrInvalidFsyncInterval = errors.New("fsync interval must be positive")
+
+	ErrInvalidCompression  = errors.New("invalid compression")
+	ErrCompressionRequired = errors.New("compression required for compressed views")
+
+	ErrInvalidBitmapFormat = errors.New("invalid bitmap format")
 
 	ErrName  = errors.New("invalid index or frame's name, must match [a-z0-9_-]")
 	ErrLabel = errors.New("invalid row or column label, must match [A-Za-z0-9_-]")
@@ -71,6 +90,7 @@ var (
 	// ErrFragmentNotFound is returned when a fragment does not exist.
 	ErrFragmentNotFound = errors.New("fragment not found")
 	ErrQueryRequired    = errors.New("query required")
+	ErrInvalidCursor    = errors.New("invalid cursor")
 	ErrTooManyWrites    = errors.New("too many write commands")
 
 	ErrConfigClusterTypeInvalid = errors.New("invalid cluster type")
@@ -87,6 +107,7 @@ var labelRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]{0,63}$`)
 // Can have a set of attributes attached to it.
 type ColumnAttrSet struct {
 	ID    uint64                 `json:"id"`
+	Key   string                 `json:"key,omitempty"`
 	Attrs map[string]interface{} `json:"attrs,omitempty"`
 }
 
@@ -112,6 +133,7 @@ func decodeColumnAttrSets(a []*internal.ColumnAttrSet) []*ColumnAttrSet {
 func encodeColumnAttrSet(set *ColumnAttrSet) *internal.ColumnAttrSet {
 	return &internal.ColumnAttrSet{
 		ID:    set.ID,
+		Key:   set.Key,
 		Attrs: encodeAttrs(set.Attrs),
 	}
 }
@@ -119,7 +141,8 @@ func encodeColumnAttrSet(set *ColumnAttrSet) *internal.ColumnAttrSet {
 // decodeColumnAttrSet converts b from its internal representation.
 func decodeColumnAttrSet(pb *internal.ColumnAttrSet) *ColumnAttrSet {
 	set := &ColumnAttrSet{
-		ID: pb.ID,
+		ID:  pb.ID,
+		Key: pb.Key,
 	}
 
 	if len(pb.Attrs) > 0 {
@@ -25,6 +25,10 @@ import (
 
 // Query represents a PQL query.
 type Query struct {
+	// Named calls defined with "let". Calls which reference a binding
+	// share the bound call.
+	Bindings []*Binding
+
 	Calls []*Call
 }
 
@@ -32,8 +36,7 @@ type Query struct {
 func (q *Query) WriteCallN() int {
 	var n int
 	for _, call := range q.Calls {
-		switch call.Name {
-		case "SetBit", "ClearBit", "SetRowAttrs", "SetColumnAttrs":
+		if call.IsWrite() {
 			n++
 		}
 	}
@@ -42,13 +45,37 @@ func (q *Query) WriteCallN() int {
 
 // String returns a string representation of the query.
 func (q *Query) String() string {
-	a := make([]string, len(q.Calls))
-	for i, call := range q.Calls {
-		a[i] = call.String()
+	a := make([]string, 0, len(q.Bindings)+len(q.Calls))
+
+	// Write bindings in order so they only reference earlier bindings.
+	refs := make(map[*Call]string, len(q.Bindings))
+	for _, b := range q.Bindings {
+		a = append(a, "let "+b.Name+" = "+b.Call.string(refs))
+		refs[b.Call] = b.Name
+	}
+
+	for _, call := range q.Calls {
+		if name, ok := refs[call]; ok {
+			a = append(a, name)
+			continue
+		}
+		a = append(a, call.string(refs))
 	}
 	return strings.Join(a, "\n")
 }
 
+// Binding represents a call bound to a name which can be referenced by later
+// calls in the same query.
+type Binding struct {
+	Name string
+	Call *Call
+}
+
+// String returns a string representation of the binding.
+func (b *Binding) String() string {
+	return "let " + b.Name + " = " + b.Call.String()
+}
+
 // Call represents a function call in the AST.
 type Call struct {
 	Name     string
@@ -131,6 +158,12 @@ func (c *Call) Clone() *Call {
 
 // String returns the string representation of the call.
 func (c *Call) String() string {
+	return c.string(nil)
+}
+
+// string returns a string representation of the call.
+// Children in refs are written as references to their binding names.
+func (c *Call) string(refs map[*Call]string) string {
 	var buf bytes.Buffer
 
 	// Write name.
@@ -148,7 +181,11 @@ func (c *Call) String() string {
 		if i > 0 {
 			buf.WriteString(", ")
 		}
-		buf.WriteString(child.String())
+		if name, ok := refs[child]; ok {
+			buf.WriteString(name)
+			continue
+		}
+		buf.WriteString(child.string(refs))
 	}
 
 	// Separate children and args, if necessary.
@@ -177,6 +214,15 @@ func (c *Call) String() string {
 	return buf.String()
 }
 
+// IsWrite returns true if the call mutates data.
+func (c *Call) IsWrite() bool {
+	switch c.Name {
+	case "SetBit", "ClearBit", "ClearRow", "SetRowAttrs", "SetColumnAttrs", "Store":
+		return true
+	}
+	return false
+}
+
 // SupportsInverse indicates that the call may be on an inverse frame.
 func (c *Call) SupportsInverse() bool {
 	return c.Name == "Bitmap" || c.Name == "TopN"
@@ -260,10 +306,14 @@ func FormatValue(v interface{}) string {
 		return fmt.Sprintf("%s", joinInterfaceSlice(v))
 	case []uint64:
 		return fmt.Sprintf("%s", joinUint64Slice(v))
+	case float64:
+		return formatFloat(v)
 	case time.Time:
 		return fmt.Sprintf("\"%s\"", v.Format(TimeFormat))
 	case *Condition:
 		return v.String()
+	case *Call:
+		return v.String()
 	default:
 		return fmt.Sprintf("%v", v)
 	}
@@ -273,6 +323,9 @@ func FormatValue(v interface{}) string {
 func CopyArgs(m map[string]interface{}) map[string]interface{} {
 	other := make(map[string]interface{}, len(m))
 	for k, v := range m {
+		if call, ok := v.(*Call); ok {
+			v = call.Clone()
+		}
 		other[k] = v
 	}
 	return other
@@ -284,6 +337,8 @@ func joinInterfaceSlice(a []interface{}) string {
 		switch v := a[i].(type) {
 		case string:
 			other[i] = fmt.Sprintf("%q", v)
+		case float64:
+			other[i] = formatFloat(v)
 		default:
 			other[i] = fmt.Sprintf("%v", v)
 		}
@@ -291,6 +346,15 @@ func joinInterfaceSlice(a []interface{}) string {
 	return "[" + strings.Join(other, ",") + "]"
 }
 
+// formatFloat returns a representation of v which the scanner reads as FLOAT.
+func formatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
 func joinUint64Slice(a []uint64) string {
 	other := make([]string, len(a))
 	for i := range a {
@@ -41,6 +41,30 @@ func TestCall_String(t *testing.T) {
 			t.Fatalf("unexpected string: %s", s)
 		}
 	})
+	t.Run("With Float Args", func(t *testing.T) {
+		c := &pql.Call{
+			Name: "Range",
+			Args: map[string]interface{}{
+				"x": &pql.Condition{Op: pql.GT, Value: 0.00001},
+				"y": &pql.Condition{Op: pql.BETWEEN, Value: []interface{}{float64(2), 1.25}},
+			},
+		}
+		if s := c.String(); s != `Range(x > 0.00001, y >< [2.0,1.25])` {
+			t.Fatalf("unexpected string: %s", s)
+		}
+	})
+	t.Run("With Call Arg", func(t *testing.T) {
+		c := &pql.Call{
+			Name:     "GroupBy",
+			Children: []*pql.Call{{Name: "Rows", Args: map[string]interface{}{"frame": "a"}}},
+			Args: map[string]interface{}{
+				"filter": &pql.Call{Name: "Bitmap", Args: map[string]interface{}{"rowID": 10}},
+			},
+		}
+		if s := c.String(); s != `GroupBy(Rows(frame="a"), filter=Bitmap(rowID=10))` {
+			t.Fatalf("unexpected string: %s", s)
+		}
+	})
 }
 
 // Ensure condition can handle values for BETWEEN operator.
@@ -27,12 +27,16 @@ const TimeFormat = "2006-01-02T15:04"
 // Parser represents a parser for the PQL language.
 type Parser struct {
 	scanner *bufScanner
+
+	// Calls bound to names by "let".
+	bindings map[string]*Call
 }
 
 // NewParser returns a new instance of Parser.
 func NewParser(r io.Reader) *Parser {
 	return &Parser{
-		scanner: newBufScanner(r),
+		scanner:  newBufScanner(r),
+		bindings: make(map[string]*Call),
 	}
 }
 
@@ -45,7 +49,23 @@ func ParseString(s string) (*Query, error) {
 func (p *Parser) Parse() (*Query, error) {
 	q := &Query{}
 	for {
-		call, err := p.parseCall()
+		// Parse binding definitions.
+		if tok, _, lit := p.scanIgnoreWhitespace(); tok == IDENT && lit == "let" {
+			if tok, _, _ := p.scanIgnoreWhitespace(); tok == IDENT {
+				p.unscan(1)
+				b, err := p.parseBinding()
+				if err != nil {
+					return nil, err
+				}
+				q.Bindings = append(q.Bindings, b)
+				continue
+			}
+			p.unscanIgnoreWhitespace(2)
+		} else {
+			p.unscanIgnoreWhitespace(1)
+		}
+
+		call, err := p.parseCallOrRef()
 		if err == io.EOF {
 			break
 		} else if err != nil {
@@ -62,6 +82,57 @@ func (p *Parser) Parse() (*Query, error) {
 	return q, nil
 }
 
+// parseBinding parses the name & call of a "let" statement.
+func (p *Parser) parseBinding() (*Binding, error) {
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != IDENT {
+		return nil, parseErrorf(pos, "expected binding name, found %q", lit)
+	} else if _, ok := p.bindings[lit]; ok {
+		return nil, parseErrorf(pos, "binding already defined: %s", lit)
+	}
+	name := lit
+
+	if tok, pos, lit := p.scanIgnoreWhitespace(); tok != ASSIGN {
+		return nil, parseErrorf(pos, "expected equals sign, found %q", lit)
+	}
+
+	call, err := p.parseCallOrRef()
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return nil, err
+	}
+
+	p.bindings[name] = call
+	return &Binding{Name: name, Call: call}, nil
+}
+
+// parseCallOrRef parses the next function call or a reference to a binding.
+func (p *Parser) parseCallOrRef() (*Call, error) {
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != IDENT {
+		p.unscan(1)
+		return p.parseCall()
+	}
+
+	// Identifiers which are not followed by a paren reference a binding.
+	if tok, _, _ := p.scan(); tok == LPAREN {
+		p.unscan(2)
+		return p.parseCall()
+	}
+	p.unscan(1)
+	return p.ref(pos, lit)
+}
+
+// ref returns the call bound to name.
+func (p *Parser) ref(pos Pos, name string) (*Call, error) {
+	call, ok := p.bindings[name]
+	if !ok {
+		return nil, parseErrorf(pos, "undefined binding: %s", name)
+	}
+	return call, nil
+}
+
 // parseCall parses the next function call.
 func (p *Parser) parseCall() (*Call, error) {
 	var c Call
@@ -116,24 +187,35 @@ func (p *Parser) parseChildren() ([]*Call, error) {
 	var offset int
 	var children []*Call
 	for {
-		// Ensure next two tokens are IDENT+LPAREN.
-		if tok, _, _ := p.scanIgnoreWhitespace(); tok != IDENT {
+		// Ensure next token is an IDENT.
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		if tok != IDENT {
 			p.unscanIgnoreWhitespace(1 + offset)
 			return children, nil
 		}
-		if tok, _, _ := p.scan(); tok != LPAREN {
+
+		// An IDENT followed by a comma or right paren references a binding.
+		// Otherwise the next token must be LPAREN to parse as a call.
+		if next, _, _ := p.scanIgnoreWhitespace(); next == COMMA || next == RPAREN {
+			p.unscan(1)
+			child, err := p.ref(pos, lit)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		} else if next != LPAREN {
 			p.unscanIgnoreWhitespace(2 + offset)
 			return children, nil
+		} else {
+			// Push tokens back on scanner and parse as a call.
+			p.unscanIgnoreWhitespace(2)
+			child, err := p.parseCall()
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
 		}
 
-		// Push tokens back on scanner and parse as a call.
-		p.unscan(2)
-		child, err := p.parseCall()
-		if err != nil {
-			return nil, err
-		}
-		children = append(children, child)
-
 		// Exit if closing paren.
 		if tok, pos, lit := p.scanIgnoreWhitespace(); tok == RPAREN {
 			p.unscan(1)
@@ -176,6 +258,23 @@ func (p *Parser) parseArgs() (map[string]interface{}, error) {
 		tok, pos, lit = p.scanIgnoreWhitespace()
 		switch tok {
 		case IDENT:
+			// Parse the value as a call if it's followed by a paren.
+			if tok, _, _ := p.scan(); tok == LPAREN {
+				p.unscan(2)
+				v, err := p.parseCall()
+				if err != nil {
+					return nil, err
+				}
+				value = v
+				break
+			}
+			p.unscan(1)
+
+			// Bindings are only allowed in place of child calls.
+			if _, ok := p.bindings[lit]; ok {
+				return nil, parseErrorf(pos, "binding cannot be used as an argument value: %s", lit)
+			}
+
 			if lit == "true" {
 				value = true
 			} else if lit == "false" {
@@ -255,6 +354,12 @@ func (p *Parser) parseList() ([]interface{}, error) {
 				return nil, err
 			}
 			values = append(values, v)
+		case FLOAT:
+			v, err := strconv.ParseFloat(lit, 64)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
 		default:
 			return nil, parseErrorf(pos, "invalid list value: %q", lit)
 		}
@@ -170,6 +170,24 @@ func TestParser_Parse(t *testing.T) {
 		}
 	})
 
+	// Parse a list argument with floats.
+	t.Run("ListArgumentFloat", func(t *testing.T) {
+		q, err := pql.ParseString(`Range(frame="f", x >< [1.25, 10])`)
+		if err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(q.Calls[0],
+			&pql.Call{
+				Name: "Range",
+				Args: map[string]interface{}{
+					"frame": "f",
+					"x":     &pql.Condition{Op: pql.BETWEEN, Value: []interface{}{1.25, int64(10)}},
+				},
+			},
+		) {
+			t.Fatalf("unexpected call: %#v", q.Calls[0])
+		}
+	})
+
 	// Parse with condition arguments.
 	t.Run("WithCondition", func(t *testing.T) {
 		q, err := pql.ParseString(`MyCall(key=foo, x == 12.25, y >= 100, z >< [4,8], m != null)`)
@@ -191,4 +209,92 @@ func TestParser_Parse(t *testing.T) {
 		}
 	})
 
+	// Parse with a call as an argument value.
+	t.Run("CallArgument", func(t *testing.T) {
+		q, err := pql.ParseString(`GroupBy(Rows(frame=a), filter=Bitmap(frame="f", rowID=10))`)
+		if err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(q.Calls[0],
+			&pql.Call{
+				Name: "GroupBy",
+				Children: []*pql.Call{
+					{Name: "Rows", Args: map[string]interface{}{"frame": "a"}},
+				},
+				Args: map[string]interface{}{
+					"filter": &pql.Call{Name: "Bitmap", Args: map[string]interface{}{"frame": "f", "rowID": int64(10)}},
+				},
+			},
+		) {
+			t.Fatalf("unexpected call: %#v", q.Calls[0])
+		}
+	})
+
+	// Parse bindings and references to them.
+	t.Run("Binding", func(t *testing.T) {
+		q, err := pql.ParseString(`
+			let seg = Intersect(Bitmap(frame=f, rowID=1), Bitmap(frame=g, rowID=2))
+			let both = Union(seg , Bitmap(frame=h, rowID=3))
+			Count(seg)
+			TopN(seg, frame=f, n=2)
+			both
+		`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		seg := &pql.Call{
+			Name: "Intersect",
+			Children: []*pql.Call{
+				{Name: "Bitmap", Args: map[string]interface{}{"frame": "f", "rowID": int64(1)}},
+				{Name: "Bitmap", Args: map[string]interface{}{"frame": "g", "rowID": int64(2)}},
+			},
+		}
+		if len(q.Bindings) != 2 || q.Bindings[0].Name != "seg" || q.Bindings[1].Name != "both" {
+			t.Fatalf("unexpected bindings: %v", q.Bindings)
+		} else if !reflect.DeepEqual(q.Bindings[0].Call, seg) {
+			t.Fatalf("unexpected binding: %s", q.Bindings[0])
+		} else if len(q.Calls) != 3 {
+			t.Fatalf("unexpected calls: %s", q)
+		}
+
+		// References share the bound call.
+		if q.Calls[0].Name != "Count" || q.Calls[0].Children[0] != q.Bindings[0].Call {
+			t.Fatalf("unexpected call: %s", q.Calls[0])
+		} else if q.Calls[1].Children[0] != q.Bindings[0].Call || !reflect.DeepEqual(q.Calls[1].Args, map[string]interface{}{"frame": "f", "n": int64(2)}) {
+			t.Fatalf("unexpected call: %s", q.Calls[1])
+		} else if q.Calls[2] != q.Bindings[1].Call || q.Bindings[1].Call.Children[0] != q.Bindings[0].Call {
+			t.Fatalf("unexpected call: %s", q.Calls[2])
+		}
+
+		// Bindings are retained when converting back to a string.
+		if s := q.String(); s != `let seg = Intersect(Bitmap(frame="f", rowID=1), Bitmap(frame="g", rowID=2))
+let both = Union(seg, Bitmap(frame="h", rowID=3))
+Count(seg)
+TopN(seg, frame="f", n=2)
+both` {
+			t.Fatalf("unexpected string: %s", s)
+		} else if other, err := pql.ParseString(s); err != nil {
+			t.Fatal(err)
+		} else if other.String() != s {
+			t.Fatalf("unexpected round trip: %s", other)
+		}
+	})
+
+	// Ensure invalid bindings return errors.
+	t.Run("BindingErrors", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			err   string
+		}{
+			{query: `Count(seg)`, err: `undefined binding: seg occurred at line 1, char 7`},
+			{query: `seg`, err: `undefined binding: seg occurred at line 1, char 1`},
+			{query: "let a = Bitmap()\nlet a = Bitmap()", err: `binding already defined: a occurred at line 2, char 5`},
+			{query: `let a Bitmap()`, err: `expected equals sign, found "Bitmap" occurred at line 1, char 7`},
+			{query: "let seg = Bitmap()\nTopN(frame=f, filter=seg)", err: `binding cannot be used as an argument value: seg occurred at line 2, char 22`},
+		} {
+			if _, err := pql.ParseString(tt.query); err == nil || err.Error() != tt.err {
+				t.Fatalf("%s: unexpected error: %v", tt.query, err)
+			}
+		}
+	})
 }
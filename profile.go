@@ -0,0 +1,144 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pilosa/pilosa/internal"
+)
+
+// Profile records the timing of a single step of query execution.
+// Steps form a tree which includes the sub-profiles of remote nodes.
+type Profile struct {
+	mu    sync.Mutex
+	start time.Time
+
+	// Type of step: "query", "parse", "call", "slice", or "remote".
+	Name string `json:"name"`
+
+	// PQL representation of the call, if the step executes a call.
+	Call string `json:"call,omitempty"`
+
+	// Host which performed the step.
+	Host string `json:"host,omitempty"`
+
+	// Slices processed by the step.
+	Slices []uint64 `json:"slices,omitempty"`
+
+	// Elapsed time of the step, in nanoseconds.
+	Duration time.Duration `json:"duration"`
+
+	// Cardinality of the bitmap produced by the step, if any.
+	Count uint64 `json:"count,omitempty"`
+
+	Children []*Profile `json:"children,omitempty"`
+}
+
+// NewProfile returns a new root profile step and starts its timer.
+func NewProfile(name, host string) *Profile {
+	return &Profile{Name: name, Host: host, start: time.Now()}
+}
+
+// Start adds child as a sub-step of p and starts its timer.
+// Returns nil if p is nil so that unprofiled queries incur no bookkeeping.
+func (p *Profile) Start(child *Profile) *Profile {
+	if p == nil {
+		return nil
+	}
+	child.start = time.Now()
+	p.Add(child)
+	return child
+}
+
+// Add attaches a completed sub-profile, such as one returned by a remote node.
+func (p *Profile) Add(child *Profile) {
+	if p == nil || child == nil {
+		return
+	}
+	p.mu.Lock()
+	p.Children = append(p.Children, child)
+	p.mu.Unlock()
+}
+
+// Finish stops the timer for p and records the cardinality of result,
+// if it is a bitmap.
+func (p *Profile) Finish(result interface{}) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.Duration = time.Since(p.start)
+	if bm, ok := result.(*Bitmap); ok && bm != nil {
+		p.Count = bm.Count()
+	}
+}
+
+// profileContextKey is the context key for the active profile step.
+type profileContextKey struct{}
+
+// WithProfile returns a copy of ctx which records steps under p.
+func WithProfile(ctx context.Context, p *Profile) context.Context {
+	if p == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, profileContextKey{}, p)
+}
+
+// ProfileFromContext returns the active profile step in ctx.
+// Returns nil if the query is not being profiled.
+func ProfileFromContext(ctx context.Context) *Profile {
+	p, _ := ctx.Value(profileContextKey{}).(*Profile)
+	return p
+}
+
+func encodeProfile(p *Profile) *internal.Profile {
+	if p == nil {
+		return nil
+	}
+	pb := &internal.Profile{
+		Name:     p.Name,
+		Call:     p.Call,
+		Host:     p.Host,
+		Slices:   p.Slices,
+		Duration: int64(p.Duration),
+		Count:    p.Count,
+	}
+	for _, child := range p.Children {
+		pb.Children = append(pb.Children, encodeProfile(child))
+	}
+	return pb
+}
+
+func decodeProfile(pb *internal.Profile) *Profile {
+	if pb == nil {
+		return nil
+	}
+	p := &Profile{
+		Name:     pb.Name,
+		Call:     pb.Call,
+		Host:     pb.Host,
+		Slices:   pb.Slices,
+		Duration: time.Duration(pb.Duration),
+		Count:    pb.Count,
+	}
+	for _, child := range pb.Children {
+		p.Children = append(p.Children, decodeProfile(child))
+	}
+	return p
+}
@@ -0,0 +1,374 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roaring
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The portable format is described by the RoaringFormatSpec:
+// https://github.com/RoaringBitmap/RoaringFormatSpec
+const (
+	// portableCookie starts bitmaps with run containers. The upper 16 bits
+	// hold the number of containers minus one.
+	portableCookie = 12347
+
+	// portableCookieNoRun starts bitmaps without run containers and is
+	// followed by a 32-bit container count.
+	portableCookieNoRun = 12346
+
+	// portableNoOffsetThreshold is the container count below which bitmaps
+	// with run containers omit the offset header.
+	portableNoOffsetThreshold = 4
+)
+
+// ErrValueTooLarge is returned when writing values larger than 32 bits in
+// the portable 32-bit format.
+var ErrValueTooLarge = errors.New("value exceeds 32 bits")
+
+// WritePortable32To writes the bitmap to w in the portable 32-bit format.
+// Returns ErrValueTooLarge if the bitmap contains values over 32 bits.
+func (b *Bitmap) WritePortable32To(w io.Writer) (n int64, err error) {
+	if len(b.keys) > 0 && b.keys[len(b.keys)-1]>>16 != 0 {
+		return 0, ErrValueTooLarge
+	}
+	nn, err := w.Write(appendPortable(nil, b.keys, b.containers))
+	return int64(nn), err
+}
+
+// WritePortable64To writes the bitmap to w in the portable 64-bit format:
+// the number of 32-bit bitmaps followed by the upper 32 bits of each
+// bitmap's values and the bitmap in the portable 32-bit format.
+func (b *Bitmap) WritePortable64To(w io.Writer) (n int64, err error) {
+	// Group containers by the upper 32 bits of their values.
+	type bucket struct {
+		high       uint32
+		keys       []uint64
+		containers []*container
+	}
+	var buckets []*bucket
+	for i, key := range b.keys {
+		if b.containers[i].n == 0 {
+			continue
+		}
+		high := uint32(key >> 16)
+		if len(buckets) == 0 || buckets[len(buckets)-1].high != high {
+			buckets = append(buckets, &bucket{high: high})
+		}
+		bkt := buckets[len(buckets)-1]
+		bkt.keys = append(bkt.keys, key)
+		bkt.containers = append(bkt.containers, b.containers[i])
+	}
+
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, uint64(len(buckets)))
+	for _, bkt := range buckets {
+		buf = appendUint32(buf, bkt.high)
+		buf = appendPortable(buf, bkt.keys, bkt.containers)
+	}
+
+	nn, err := w.Write(buf)
+	return int64(nn), err
+}
+
+// UnmarshalPortable32 decodes data in the portable 32-bit format into the
+// bitmap, replacing its contents.
+func (b *Bitmap) UnmarshalPortable32(data []byte) error {
+	keys, containers, sz, err := readPortable(data, 0)
+	if err != nil {
+		return err
+	} else if sz != len(data) {
+		return fmt.Errorf("unexpected data after bitmap: off=%d, len=%d", sz, len(data))
+	}
+
+	b.keys, b.containers = keys, containers
+	return nil
+}
+
+// UnmarshalPortable64 decodes data in the portable 64-bit format into the
+// bitmap, replacing its contents.
+func (b *Bitmap) UnmarshalPortable64(data []byte) error {
+	if len(data) < 8 {
+		return errors.New("data too small")
+	}
+	bucketN := binary.LittleEndian.Uint64(data[0:8])
+	buf := data[8:]
+
+	var keys []uint64
+	var containers []*container
+	for i := uint64(0); i < bucketN; i++ {
+		if len(buf) < 4 {
+			return errors.New("bucket header out of bounds")
+		}
+		high := binary.LittleEndian.Uint32(buf[0:4])
+		if len(keys) > 0 && uint64(high) <= keys[len(keys)-1]>>16 {
+			return fmt.Errorf("bucket out of order: %d", high)
+		}
+
+		k, c, sz, err := readPortable(buf[4:], uint64(high)<<16)
+		if err != nil {
+			return fmt.Errorf("bucket %d: %s", high, err)
+		}
+		keys, containers = append(keys, k...), append(containers, c...)
+		buf = buf[4+sz:]
+	}
+
+	if len(buf) != 0 {
+		return fmt.Errorf("unexpected data after bitmap: len=%d", len(buf))
+	}
+
+	b.keys, b.containers = keys, containers
+	return nil
+}
+
+// appendPortable appends containers to buf in the portable 32-bit format.
+// Only the lower 16 bits of each key are written. Empty containers are skipped.
+func appendPortable(buf []byte, keys []uint64, containers []*container) []byte {
+	var ks []uint64
+	var cs []*container
+	hasRun := false
+	for i, c := range containers {
+		if c.n == 0 {
+			continue
+		}
+		ks, cs = append(ks, keys[i]), append(cs, c)
+		hasRun = hasRun || c.isRun()
+	}
+	start := len(buf)
+
+	// Write cookie, container count & the run container flags.
+	if hasRun {
+		buf = appendUint32(buf, portableCookie|uint32(len(cs)-1)<<16)
+		flags := make([]byte, (len(cs)+7)/8)
+		for i, c := range cs {
+			if c.isRun() {
+				flags[i/8] |= 1 << uint(i%8)
+			}
+		}
+		buf = append(buf, flags...)
+	} else {
+		buf = appendUint32(buf, portableCookieNoRun)
+		buf = appendUint32(buf, uint32(len(cs)))
+	}
+
+	// Write key & cardinality of each container.
+	for i, c := range cs {
+		buf = appendUint16(buf, uint16(ks[i]))
+		buf = appendUint16(buf, uint16(c.n-1))
+	}
+
+	// Write the offset of each container, relative to the start of the bitmap.
+	if !hasRun || len(cs) >= portableNoOffsetThreshold {
+		offset := len(buf) - start + 4*len(cs)
+		for _, c := range cs {
+			buf = appendUint32(buf, uint32(offset))
+			offset += portableContainerSize(c)
+		}
+	}
+
+	// Write container data.
+	for _, c := range cs {
+		switch {
+		case c.isRun():
+			buf = appendUint16(buf, uint16(len(c.runs)))
+			for _, r := range c.runs {
+				buf = appendUint16(buf, r.start)
+				buf = appendUint16(buf, r.last-r.start)
+			}
+		case c.n > ArrayMaxSize:
+			for _, v := range c.bitmapWords() {
+				buf = appendUint64(buf, v)
+			}
+		default:
+			for _, v := range c.arrayValues() {
+				buf = appendUint16(buf, v)
+			}
+		}
+	}
+
+	return buf
+}
+
+// portableContainerSize returns the size of c in the portable format.
+func portableContainerSize(c *container) int {
+	switch {
+	case c.isRun():
+		return runCountHeaderSize + len(c.runs)*interval16Size
+	case c.n > ArrayMaxSize:
+		return bitmapN * 8
+	default:
+		return c.n * 2
+	}
+}
+
+// readPortable reads a bitmap in the portable 32-bit format from the start
+// of data. The high bits are added to each container key. Returns the
+// number of bytes read.
+func readPortable(data []byte, high uint64) (keys []uint64, containers []*container, sz int, err error) {
+	if len(data) < 4 {
+		return nil, nil, 0, errors.New("data too small")
+	}
+
+	// Read cookie, container count & the run container flags.
+	var size, pos int
+	var runFlags []byte
+	cookie := binary.LittleEndian.Uint32(data[0:4])
+	switch {
+	case cookie&0xFFFF == portableCookie:
+		size = int(cookie>>16) + 1
+		pos = 4 + (size+7)/8
+		if len(data) < pos {
+			return nil, nil, 0, errors.New("run flags out of bounds")
+		}
+		runFlags = data[4:pos]
+	case cookie == portableCookieNoRun:
+		if len(data) < 8 {
+			return nil, nil, 0, errors.New("container count out of bounds")
+		}
+		size, pos = int(binary.LittleEndian.Uint32(data[4:8])), 8
+		if size > 1<<16 {
+			return nil, nil, 0, fmt.Errorf("too many containers: %d", size)
+		}
+	default:
+		return nil, nil, 0, fmt.Errorf("invalid portable roaring cookie: %d", cookie)
+	}
+	isRun := func(i int) bool { return runFlags != nil && runFlags[i/8]&(1<<uint(i%8)) != 0 }
+
+	// Read key & cardinality of each container.
+	if len(data) < pos+size*4 {
+		return nil, nil, 0, errors.New("container header out of bounds")
+	}
+	keys = make([]uint64, size)
+	containers = make([]*container, size)
+	for i := 0; i < size; i++ {
+		buf := data[pos+i*4:]
+		keys[i] = high | uint64(binary.LittleEndian.Uint16(buf[0:2]))
+		if i > 0 && keys[i] <= keys[i-1] {
+			return nil, nil, 0, fmt.Errorf("container out of order: key=%d", keys[i])
+		}
+		containers[i] = &container{n: int(binary.LittleEndian.Uint16(buf[2:4])) + 1}
+	}
+	pos += size * 4
+
+	// Skip offsets since containers are stored in order.
+	if runFlags == nil || size >= portableNoOffsetThreshold {
+		pos += size * 4
+	}
+
+	// Read container data.
+	for i, c := range containers {
+		switch {
+		case isRun(i):
+			if len(data) < pos+runCountHeaderSize {
+				return nil, nil, 0, errors.New("run count out of bounds")
+			}
+			runN := int(binary.LittleEndian.Uint16(data[pos:]))
+			pos += runCountHeaderSize
+			if len(data) < pos+runN*interval16Size {
+				return nil, nil, 0, errors.New("runs out of bounds")
+			}
+			c.container_type = ContainerRun
+			c.runs = make([]interval16, runN)
+			for j := range c.runs {
+				start := binary.LittleEndian.Uint16(data[pos:])
+				length := binary.LittleEndian.Uint16(data[pos+2:])
+				if int(start)+int(length) > maxContainerVal {
+					return nil, nil, 0, fmt.Errorf("run out of range: start=%d, len=%d", start, length)
+				}
+				c.runs[j] = interval16{start: start, last: start + length}
+				pos += interval16Size
+			}
+		case c.n > ArrayMaxSize:
+			if len(data) < pos+bitmapN*8 {
+				return nil, nil, 0, errors.New("bitmap out of bounds")
+			}
+			c.container_type = ContainerBitmap
+			c.bitmap = make([]uint64, bitmapN)
+			for j := range c.bitmap {
+				c.bitmap[j] = binary.LittleEndian.Uint64(data[pos:])
+				pos += 8
+			}
+		default:
+			if len(data) < pos+c.n*2 {
+				return nil, nil, 0, errors.New("array out of bounds")
+			}
+			c.container_type = ContainerArray
+			c.array = make([]uint16, c.n)
+			for j := range c.array {
+				c.array[j] = binary.LittleEndian.Uint16(data[pos:])
+				pos += 2
+			}
+		}
+
+		// Don't rely on the cardinality in the header.
+		c.n = c.count()
+	}
+
+	return keys, containers, pos, nil
+}
+
+// arrayValues returns the values of the container in order.
+func (c *container) arrayValues() []uint16 {
+	switch {
+	case c.isArray():
+		return c.array
+	case c.isRun():
+		a := make([]uint16, 0, c.n)
+		for _, r := range c.runs {
+			for v := int(r.start); v <= int(r.last); v++ {
+				a = append(a, uint16(v))
+			}
+		}
+		return a
+	default:
+		a := make([]uint16, 0, c.n)
+		for i, w := range c.bitmap {
+			for j := uint(0); w != 0; j, w = j+1, w>>1 {
+				if w&1 != 0 {
+					a = append(a, uint16(i*64+int(j)))
+				}
+			}
+		}
+		return a
+	}
+}
+
+// bitmapWords returns the values of the container as a bitmap.
+func (c *container) bitmapWords() []uint64 {
+	if c.isBitmap() {
+		return c.bitmap
+	}
+
+	a := make([]uint64, bitmapN)
+	for _, v := range c.arrayValues() {
+		a[v/64] |= 1 << (v % 64)
+	}
+	return a
+}
+
+func appendUint16(buf []byte, v uint16) []byte {
+	return append(buf, byte(v), byte(v>>8))
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	return appendUint32(appendUint32(buf, uint32(v)), uint32(v>>32))
+}
@@ -481,6 +481,246 @@ func (b *Bitmap) Xor(other *Bitmap) *Bitmap {
 	return output
 }
 
+// Union returns the union of bitmaps. Containers sharing a key are merged
+// across all inputs at once instead of building intermediate bitmaps.
+func Union(bitmaps ...*Bitmap) *Bitmap {
+	output := &Bitmap{}
+	output.UnionInPlace(bitmaps...)
+	return output
+}
+
+// Intersect returns the intersection of bitmaps. Containers sharing a key are
+// intersected across all inputs at once instead of building intermediate bitmaps.
+func Intersect(bitmaps ...*Bitmap) *Bitmap {
+	switch len(bitmaps) {
+	case 0:
+		return &Bitmap{}
+	case 1:
+		return bitmaps[0].Clone()
+	}
+
+	output := &Bitmap{}
+	output.keys, output.containers = intersectBitmaps(bitmaps)
+	return output
+}
+
+// UnionInPlace sets b to the union of b and others.
+//
+// Containers in b are modified directly and others are never modified, so
+// the caller must own b but not others. Containers for keys which are missing
+// from b are copied from others.
+func (b *Bitmap) UnionInPlace(others ...*Bitmap) {
+	var keys []uint64
+	var containers []*container
+
+	cs := make([]*container, 0, len(others))
+	itr := newBitmapsIterator(append([]*Bitmap{b}, others...))
+	for key, a, eof := itr.next(); !eof; key, a, eof = itr.next() {
+		cs = cs[:0]
+		for _, c := range a[1:] {
+			if c != nil && c != a[0] {
+				cs = append(cs, c)
+			}
+		}
+
+		if len(cs) == 0 {
+			continue
+		} else if a[0] != nil {
+			a[0].unionInPlace(cs)
+			continue
+		}
+
+		// New containers are inserted once iteration is done.
+		keys = append(keys, key)
+		containers = append(containers, unionN(cs))
+	}
+
+	b.insertContainers(keys, containers)
+}
+
+// IntersectInPlace sets b to the intersection of b and others.
+// Containers in b are modified directly; others are never modified.
+func (b *Bitmap) IntersectInPlace(others ...*Bitmap) {
+	if len(others) == 0 {
+		return
+	}
+
+	var n int
+	cs := make([]*container, 0, len(others))
+	itr := newBitmapsIterator(append([]*Bitmap{b}, others...))
+	for key, a, eof := itr.next(); !eof; key, a, eof = itr.next() {
+		// Drop keys which are missing from any bitmap.
+		c := a[0]
+		cs = cs[:0]
+		for _, other := range a[1:] {
+			if other == nil {
+				c = nil
+				break
+			} else if other != c {
+				cs = append(cs, other)
+			}
+		}
+		if c == nil {
+			continue
+		} else if c.intersectInPlace(cs); c.n == 0 {
+			continue
+		}
+
+		// Keys are only moved to positions the iterator has already read.
+		b.keys[n], b.containers[n] = key, c
+		n++
+	}
+
+	b.truncateContainers(n)
+}
+
+// DifferenceInPlace removes the bits in other from b.
+// Containers in b are modified directly; other is never modified.
+func (b *Bitmap) DifferenceInPlace(other *Bitmap) {
+	if other == b {
+		b.truncateContainers(0)
+		return
+	}
+
+	var n int
+	itr := newBitmapsIterator([]*Bitmap{b, other})
+	for key, a, eof := itr.next(); !eof; key, a, eof = itr.next() {
+		c := a[0]
+		if c == nil {
+			continue
+		} else if a[1] != nil {
+			if c.differenceInPlace(a[1]); c.n == 0 {
+				continue
+			}
+		}
+
+		b.keys[n], b.containers[n] = key, c
+		n++
+	}
+
+	b.truncateContainers(n)
+}
+
+// XorInPlace sets b to the bitwise exclusive or of b and other.
+//
+// Containers in b are modified directly and other is never modified.
+// Containers for keys which are missing from b are copied from other.
+func (b *Bitmap) XorInPlace(other *Bitmap) {
+	if other == b {
+		b.truncateContainers(0)
+		return
+	}
+
+	var n int
+	var keys []uint64
+	var containers []*container
+
+	itr := newBitmapsIterator([]*Bitmap{b, other})
+	for key, a, eof := itr.next(); !eof; key, a, eof = itr.next() {
+		c := a[0]
+		if c == nil {
+			keys = append(keys, key)
+			containers = append(containers, a[1].clone())
+			continue
+		} else if a[1] != nil {
+			if c.xorInPlace(a[1]); c.n == 0 {
+				continue
+			}
+		}
+
+		b.keys[n], b.containers[n] = key, c
+		n++
+	}
+
+	b.truncateContainers(n)
+	b.insertContainers(keys, containers)
+}
+
+// truncateContainers shortens b to its first n keys & containers.
+func (b *Bitmap) truncateContainers(n int) {
+	for i := n; i < len(b.containers); i++ {
+		b.containers[i] = nil
+	}
+	b.keys, b.containers = b.keys[:n], b.containers[:n]
+}
+
+// insertContainers adds containers for sorted keys which don't exist in b.
+// Existing containers are shifted toward the end, starting from the back,
+// so no intermediate slices are allocated.
+func (b *Bitmap) insertContainers(keys []uint64, containers []*container) {
+	if len(keys) == 0 {
+		return
+	}
+
+	i, j := len(b.keys)-1, len(keys)-1
+	b.keys = append(b.keys, keys...)
+	b.containers = append(b.containers, containers...)
+	for k := len(b.keys) - 1; j >= 0; k-- {
+		if i >= 0 && b.keys[i] > keys[j] {
+			b.keys[k], b.containers[k] = b.keys[i], b.containers[i]
+			i--
+		} else {
+			b.keys[k], b.containers[k] = keys[j], containers[j]
+			j--
+		}
+	}
+}
+
+// intersectBitmaps returns the keys & containers of the intersection of two
+// or more bitmaps. Only keys which exist in every bitmap are intersected.
+func intersectBitmaps(bitmaps []*Bitmap) (keys []uint64, containers []*container) {
+	cs := make([]*container, len(bitmaps))
+	itr := newBitmapsIterator(bitmaps)
+	for key, a, eof := itr.next(); !eof; key, a, eof = itr.next() {
+		copy(cs, a)
+		if c := intersectN(cs); c != nil && c.n > 0 {
+			keys = append(keys, key)
+			containers = append(containers, c)
+		}
+	}
+	return keys, containers
+}
+
+// bitmapsIterator iterates over the keys of multiple bitmaps in order.
+type bitmapsIterator struct {
+	bitmaps    []*Bitmap
+	pos        []int
+	containers []*container
+}
+
+// newBitmapsIterator returns a new iterator over bitmaps.
+func newBitmapsIterator(bitmaps []*Bitmap) *bitmapsIterator {
+	return &bitmapsIterator{
+		bitmaps:    bitmaps,
+		pos:        make([]int, len(bitmaps)),
+		containers: make([]*container, len(bitmaps)),
+	}
+}
+
+// next returns the next key and the container for the key in each bitmap.
+// A container is nil if its bitmap does not have the key. The returned slice
+// is reused by the next call.
+func (itr *bitmapsIterator) next() (key uint64, containers []*container, eof bool) {
+	eof = true
+	for i, b := range itr.bitmaps {
+		if p := itr.pos[i]; p < len(b.keys) && (eof || b.keys[p] < key) {
+			key, eof = b.keys[p], false
+		}
+	}
+	if eof {
+		return 0, nil, true
+	}
+
+	for i, b := range itr.bitmaps {
+		itr.containers[i] = nil
+		if p := itr.pos[i]; p < len(b.keys) && b.keys[p] == key {
+			itr.containers[i] = b.containers[p]
+			itr.pos[i]++
+		}
+	}
+	return key, itr.containers, false
+}
+
 // removeEmptyContainers deletes all containers that have a count of zero.
 func (b *Bitmap) removeEmptyContainers() {
 	for i := 0; i < len(b.containers); {
@@ -688,7 +928,11 @@ func (b *Bitmap) UnmarshalBinary(data []byte) error {
 		// Unmarshal the op and apply it.
 		var op op
 		if err := op.UnmarshalBinary(buf); err != nil {
-			// FIXME(benbjohnson): return error with position so file can be trimmed.
+			// A bad final op is most likely a partial write so report its
+			// position to allow the caller to trim the log.
+			if len(buf) <= op.size() {
+				return &TornOpError{Offset: len(data) - len(buf), Err: err}
+			}
 			return err
 		}
 		op.apply(b)
@@ -703,6 +947,18 @@ func (b *Bitmap) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// TornOpError is returned by UnmarshalBinary when the last op in the log
+// is incomplete or fails its checksum. All ops before Offset were applied.
+type TornOpError struct {
+	Offset int
+	Err    error
+}
+
+// Error returns the error message.
+func (e *TornOpError) Error() string {
+	return fmt.Sprintf("torn op: offset=%d, err=%s", e.Offset, e.Err)
+}
+
 // writeOp writes op to the OpWriter, if available.
 func (b *Bitmap) writeOp(op *op) error {
 	if b.OpWriter == nil {
@@ -810,7 +1066,8 @@ func (itr *Iterator) eof() bool { return int(itr.i) >= len(itr.bitmap.containers
 func (itr *Iterator) Seek(seek uint64) {
 	// Move to the correct container.
 	itr.i = search64(itr.bitmap.keys, highbits(seek))
-	if itr.i < 0 {
+	exact := itr.i >= 0
+	if !exact {
 		itr.i = -itr.i - 1
 	}
 	if itr.eof() {
@@ -818,7 +1075,12 @@ func (itr *Iterator) Seek(seek uint64) {
 	}
 
 	// Move to the correct value index inside the container.
+	// If the seek value's container doesn't exist then start at the
+	// beginning of the next container.
 	lb := lowbits(seek)
+	if !exact {
+		lb = 0
+	}
 	if int(itr.i) >= len(itr.bitmap.containers) {
 		panic(fmt.Sprintf("data Corruption %d %d %d", itr.i, len(itr.bitmap.containers), seek))
 	}
@@ -1912,6 +2174,31 @@ func intersect(a, b *container) *container {
 	}
 }
 
+// intersectN returns the intersection of containers, or nil if any of the
+// containers is nil. The order of a is not preserved.
+func intersectN(a []*container) *container {
+	for _, c := range a {
+		if c == nil {
+			return nil
+		}
+	}
+	if len(a) == 1 {
+		return a[0].clone()
+	}
+
+	// Start with the smallest containers so the output shrinks quickly.
+	sort.Slice(a, func(i, j int) bool { return a[i].n < a[j].n })
+
+	output := intersect(a[0], a[1])
+	for _, c := range a[2:] {
+		if output.n == 0 {
+			break
+		}
+		output = intersect(output, c)
+	}
+	return output
+}
+
 func intersectArrayArray(a, b *container) *container {
 	output := &container{container_type: ContainerArray}
 	na, nb := len(a.array), len(b.array)
@@ -2110,6 +2397,245 @@ func union(a, b *container) *container {
 	}
 }
 
+// unionN returns the union of containers. More than two containers are
+// merged into a single bitmap which is converted to an array if small enough.
+func unionN(a []*container) *container {
+	switch len(a) {
+	case 1:
+		return a[0].clone()
+	case 2:
+		return union(a[0], a[1])
+	}
+
+	output := &container{
+		bitmap:         make([]uint64, bitmapN),
+		container_type: ContainerBitmap,
+	}
+	for _, c := range a {
+		switch {
+		case c.isArray():
+			for _, v := range c.array {
+				output.bitmap[v/64] |= 1 << (v % 64)
+			}
+		case c.isRun():
+			for _, r := range c.runs {
+				for v := int(r.start); v <= int(r.last); v++ {
+					output.bitmap[v/64] |= 1 << uint(v%64)
+				}
+			}
+		default:
+			for i, v := range c.bitmap {
+				output.bitmap[i] |= v
+			}
+		}
+	}
+
+	output.n = int(popcntSlice(output.bitmap))
+	if output.n < ArrayMaxSize {
+		output.bitmapToArray()
+	}
+	return output
+}
+
+// toBitmap converts the container to a heap allocated bitmap container so
+// its words can be modified directly.
+func (c *container) toBitmap() {
+	switch {
+	case c.isArray():
+		c.arrayToBitmap()
+	case c.isRun():
+		c.runToBitmap()
+	default:
+		c.unmap()
+	}
+}
+
+// shrink converts between array & bitmap containers after the count changed.
+func (c *container) shrink() {
+	if c.isBitmap() && c.n < ArrayMaxSize {
+		c.bitmapToArray()
+	} else if c.isArray() && c.n > ArrayMaxSize {
+		c.arrayToBitmap()
+	}
+}
+
+// unionInPlace adds the values of others to c.
+func (c *container) unionInPlace(others []*container) {
+	// Merge a single array directly into an array container.
+	if len(others) == 1 && c.isArray() && others[0].isArray() {
+		c.unmap()
+		c.array = mergeArrays(c.array, others[0].array, true)
+		c.n = len(c.array)
+		c.shrink()
+		return
+	}
+
+	c.toBitmap()
+	for _, other := range others {
+		switch {
+		case other.isArray():
+			for _, v := range other.array {
+				c.bitmap[v/64] |= 1 << (v % 64)
+			}
+		case other.isRun():
+			for _, r := range other.runs {
+				c.bitmapSetRange(uint64(r.start), uint64(r.last)+1)
+			}
+		default:
+			for i, v := range other.bitmap {
+				c.bitmap[i] |= v
+			}
+		}
+	}
+	c.n = int(popcntSlice(c.bitmap))
+	c.shrink()
+}
+
+// intersectInPlace removes the values from c which are missing from any of
+// others.
+func (c *container) intersectInPlace(others []*container) {
+	for _, other := range others {
+		switch {
+		case c.isArray():
+			c.unmap()
+			n := 0
+			for _, v := range c.array {
+				if other.contains(v) {
+					c.array[n] = v
+					n++
+				}
+			}
+			c.array, c.n = c.array[:n], n
+
+		case other.isArray():
+			// The result is no larger than other so it is stored as an array.
+			a := make([]uint16, 0, other.n)
+			for _, v := range other.array {
+				if c.contains(v) {
+					a = append(a, v)
+				}
+			}
+			c.container_type, c.array, c.bitmap, c.runs, c.mapped = ContainerArray, a, nil, nil, false
+			c.n = len(a)
+
+		default:
+			c.toBitmap()
+			if other.isRun() {
+				// Clear the gaps between runs.
+				start := 0
+				for _, r := range other.runs {
+					if int(r.start) > start {
+						c.bitmapZeroRange(uint64(start), uint64(r.start))
+					}
+					start = int(r.last) + 1
+				}
+				if start <= maxContainerVal {
+					c.bitmapZeroRange(uint64(start), maxContainerVal+1)
+				}
+			} else {
+				for i, v := range other.bitmap {
+					c.bitmap[i] &= v
+				}
+			}
+			c.n = int(popcntSlice(c.bitmap))
+		}
+	}
+	c.shrink()
+}
+
+// differenceInPlace removes the values of other from c.
+func (c *container) differenceInPlace(other *container) {
+	if c.isArray() {
+		c.unmap()
+		n := 0
+		for _, v := range c.array {
+			if !other.contains(v) {
+				c.array[n] = v
+				n++
+			}
+		}
+		c.array, c.n = c.array[:n], n
+		return
+	}
+
+	c.toBitmap()
+	switch {
+	case other.isArray():
+		for _, v := range other.array {
+			c.bitmap[v/64] &^= 1 << (v % 64)
+		}
+	case other.isRun():
+		for _, r := range other.runs {
+			c.bitmapZeroRange(uint64(r.start), uint64(r.last)+1)
+		}
+	default:
+		for i, v := range other.bitmap {
+			c.bitmap[i] &^= v
+		}
+	}
+	c.n = int(popcntSlice(c.bitmap))
+	c.shrink()
+}
+
+// xorInPlace flips the values of other in c.
+func (c *container) xorInPlace(other *container) {
+	if c.isArray() && other.isArray() {
+		c.unmap()
+		c.array = mergeArrays(c.array, other.array, false)
+		c.n = len(c.array)
+		c.shrink()
+		return
+	}
+
+	c.toBitmap()
+	switch {
+	case other.isArray():
+		for _, v := range other.array {
+			c.bitmap[v/64] ^= 1 << (v % 64)
+		}
+	case other.isRun():
+		for _, r := range other.runs {
+			c.bitmapXorRange(uint64(r.start), uint64(r.last)+1)
+		}
+	default:
+		for i, v := range other.bitmap {
+			c.bitmap[i] ^= v
+		}
+	}
+	c.n = int(popcntSlice(c.bitmap))
+	c.shrink()
+}
+
+// mergeArrays merges the sorted values of b into a, which must be writable.
+// Values in both arrays are kept once if shared is true and dropped otherwise.
+// The merge runs from the back so a is only grown once.
+func mergeArrays(a, b []uint16, shared bool) []uint16 {
+	i, j := len(a)-1, len(b)-1
+	a = append(a, b...)
+	k := len(a) - 1
+	for j >= 0 {
+		switch {
+		case i >= 0 && a[i] > b[j]:
+			a[k] = a[i]
+			i--
+		case i >= 0 && a[i] == b[j]:
+			i, j = i-1, j-1
+			if !shared {
+				continue
+			}
+			a[k] = b[j+1]
+		default:
+			a[k] = b[j]
+			j--
+		}
+		k--
+	}
+
+	// Close the gap left by values which were in both arrays.
+	n := copy(a[i+1:], a[k+1:])
+	return a[:i+1+n]
+}
+
 func unionArrayArray(a, b *container) *container {
 	output := &container{container_type: ContainerArray}
 	na, nb := len(a.array), len(b.array)
@@ -2252,7 +2778,7 @@ func (c *container) bitmapSetRange(i, j uint64) {
 	x := i >> 6
 	y := (j - 1) >> 6
 	var X uint64 = maxBitmap << (i % 64)
-	var Y uint64 = maxBitmap >> (64 - (j % 64))
+	var Y uint64 = maxBitmap >> (63 - ((j - 1) % 64))
 	xcnt := popcnt(X)
 	ycnt := popcnt(Y)
 	if x == y {
@@ -2275,7 +2801,7 @@ func (c *container) bitmapXorRange(i, j uint64) {
 	x := i >> 6
 	y := (j - 1) >> 6
 	var X uint64 = maxBitmap << (i % 64)
-	var Y uint64 = maxBitmap >> (64 - (j % 64))
+	var Y uint64 = maxBitmap >> (63 - ((j - 1) % 64))
 	if x == y {
 		cnt := popcnt(c.bitmap[x])
 		c.bitmap[x] ^= (X & Y) //// flip
@@ -2300,7 +2826,7 @@ func (c *container) bitmapZeroRange(i, j uint64) {
 	x := i >> 6
 	y := (j - 1) >> 6
 	var X uint64 = maxBitmap << (i % 64)
-	var Y uint64 = maxBitmap >> (64 - (j % 64))
+	var Y uint64 = maxBitmap >> (63 - ((j - 1) % 64))
 	if x == y {
 		c.n -= int(popcnt(c.bitmap[x] & (X & Y)))
 		c.bitmap[x] &= ^(X & Y)
@@ -943,6 +943,13 @@ func TestBitmapSetRange(t *testing.T) {
 			exp:    []uint64{0xF000000000000FF0, 0xFFFFFFFFFFFFFFFF, 0xFF},
 			expN:   84,
 		},
+		{
+			bitmap: []uint64{0xFF0, 0x00, 0x00},
+			start:  60,
+			last:   127,
+			exp:    []uint64{0xF000000000000FF0, 0xFFFFFFFFFFFFFFFF, 0x00},
+			expN:   76,
+		},
 	}
 
 	for i, test := range tests {
@@ -2590,3 +2597,57 @@ func bitmapEvens() []uint64 {
 	}
 	return bitmap
 }
+
+// Ensure in-place operations modify the receiver's containers directly.
+func TestBitmap_InPlace_ReusesContainers(t *testing.T) {
+	bm := NewBitmap()
+	for v := uint64(0); v < 10000; v++ {
+		bm.Add(v)
+	}
+	bm.Add(1 << 16)
+	c0, c1 := bm.containers[0], bm.containers[1]
+
+	bm.UnionInPlace(NewBitmap(10000, 2<<16), NewBitmap(10001))
+	if bm.containers[0] != c0 || bm.containers[1] != c1 {
+		t.Fatal("expected containers to be reused by union")
+	} else if !reflect.DeepEqual(bm.keys, []uint64{0, 1, 2}) {
+		t.Fatalf("unexpected keys: %v", bm.keys)
+	} else if c0.n != 10002 {
+		t.Fatalf("unexpected count: %d", c0.n)
+	}
+
+	bm.XorInPlace(NewBitmap(0, 1<<16, 3<<16))
+	if bm.containers[0] != c0 {
+		t.Fatal("expected container to be reused by xor")
+	} else if !reflect.DeepEqual(bm.keys, []uint64{0, 2, 3}) {
+		t.Fatalf("unexpected keys: %v", bm.keys)
+	}
+
+	bm.DifferenceInPlace(NewBitmap(1, 2<<16))
+	bm.IntersectInPlace(NewBitmap(2, 3, 3<<16))
+	if bm.containers[0] != c0 {
+		t.Fatal("expected container to be reused")
+	} else if got := bm.Slice(); !reflect.DeepEqual(got, []uint64{2, 3, 3 << 16}) {
+		t.Fatalf("unexpected values: %v", got)
+	}
+}
+
+// Ensure sorted arrays are merged in place.
+func TestMergeArrays(t *testing.T) {
+	for _, tt := range []struct {
+		a, b   []uint16
+		shared bool
+		exp    []uint16
+	}{
+		{a: []uint16{1, 3, 5}, b: []uint16{2, 3, 6}, shared: true, exp: []uint16{1, 2, 3, 5, 6}},
+		{a: []uint16{1, 3, 5}, b: []uint16{2, 3, 6}, shared: false, exp: []uint16{1, 2, 5, 6}},
+		{a: []uint16{1, 2}, b: []uint16{1, 2}, shared: false, exp: []uint16{}},
+		{a: []uint16{}, b: []uint16{4}, shared: true, exp: []uint16{4}},
+		{a: []uint16{4, 5}, b: []uint16{1}, shared: true, exp: []uint16{1, 4, 5}},
+	} {
+		a := append([]uint16{}, tt.a...)
+		if got := mergeArrays(a, tt.b, tt.shared); !reflect.DeepEqual(got, tt.exp) {
+			t.Fatalf("merge(%v, %v, %v): got %v, expected %v", tt.a, tt.b, tt.shared, got, tt.exp)
+		}
+	}
+}
@@ -535,6 +535,147 @@ func TestBitmap_Union(t *testing.T) {
 	}
 }
 
+// Ensure n-ary union & intersect match pairwise operations.
+func TestBitmap_UnionIntersect_Multi(t *testing.T) {
+	// Mix array, bitmap & run containers which partially share keys.
+	bms := make([]*roaring.Bitmap, 5)
+	rand := rand.New(rand.NewSource(0))
+	for i := range bms {
+		bms[i] = roaring.NewBitmap()
+		for j := 0; j < 20000; j++ {
+			bms[i].Add(uint64(rand.Intn(300000)))
+		}
+		for j := uint64(0); j < 5000; j++ {
+			bms[i].Add(uint64(i)<<16 + j)
+		}
+		if i%2 == 0 {
+			bms[i].Optimize()
+		}
+	}
+	before := make([][]uint64, len(bms))
+	for i, bm := range bms {
+		before[i] = bm.Slice()
+	}
+
+	union, intersect := bms[0], bms[0]
+	for _, bm := range bms[1:] {
+		union, intersect = union.Union(bm), intersect.Intersect(bm)
+	}
+
+	if got := roaring.Union(bms...); !reflect.DeepEqual(got.Slice(), union.Slice()) {
+		t.Fatalf("unexpected union: n=%d, expected=%d", got.Count(), union.Count())
+	} else if got := roaring.Intersect(bms...); !reflect.DeepEqual(got.Slice(), intersect.Slice()) {
+		t.Fatalf("unexpected intersect: n=%d, expected=%d", got.Count(), intersect.Count())
+	}
+
+	// Inputs must not be modified.
+	for i, bm := range bms {
+		if !reflect.DeepEqual(bm.Slice(), before[i]) {
+			t.Fatalf("bitmap %d modified", i)
+		}
+	}
+
+	// Empty & single inputs.
+	if n := roaring.Union().Count(); n != 0 {
+		t.Fatalf("unexpected n: %d", n)
+	} else if n := roaring.Intersect().Count(); n != 0 {
+		t.Fatalf("unexpected n: %d", n)
+	} else if got := roaring.Intersect(bms[0]); !reflect.DeepEqual(got.Slice(), before[0]) {
+		t.Fatal("unexpected single intersect")
+	}
+}
+
+// Ensure in-place operations match their allocating counterparts.
+func TestBitmap_InPlace(t *testing.T) {
+	if err := quick.Check(func(a, b []uint64) bool {
+		for i := range a {
+			a[i] %= 1 << 20
+		}
+		for i := range b {
+			b[i] %= 1 << 20
+		}
+		bm0, bm1 := roaring.NewBitmap(a...), roaring.NewBitmap(b...)
+
+		for _, tt := range []struct {
+			name    string
+			inPlace func(bm *roaring.Bitmap)
+			exp     *roaring.Bitmap
+		}{
+			{"union", func(bm *roaring.Bitmap) { bm.UnionInPlace(bm1) }, bm0.Union(bm1)},
+			{"intersect", func(bm *roaring.Bitmap) { bm.IntersectInPlace(bm1) }, bm0.Intersect(bm1)},
+			{"difference", func(bm *roaring.Bitmap) { bm.DifferenceInPlace(bm1) }, bm0.Difference(bm1)},
+			{"xor", func(bm *roaring.Bitmap) { bm.XorInPlace(bm1) }, bm0.Xor(bm1)},
+		} {
+			bm := bm0.Clone()
+			tt.inPlace(bm)
+			if got, exp := bm.Slice(), tt.exp.Slice(); !reflect.DeepEqual(got, exp) {
+				t.Fatalf("%s: unexpected values: %v, expected %v", tt.name, got, exp)
+			}
+		}
+		return true
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Ensure in-place operations match their allocating counterparts when
+// array, bitmap & run containers are mixed.
+func TestBitmap_InPlace_Mixed(t *testing.T) {
+	rand := rand.New(rand.NewSource(0))
+	bms := make([]*roaring.Bitmap, 4)
+	for i := range bms {
+		bms[i] = roaring.NewBitmap()
+		for j := 0; j < 20000; j++ {
+			bms[i].Add(uint64(rand.Intn(300000)))
+		}
+		for j := uint64(0); j < 10000; j++ {
+			bms[i].Add(uint64(i)<<16 + uint64(i)*1000 + j)
+		}
+		if i%2 == 0 {
+			bms[i].Optimize()
+		}
+	}
+
+	for i, bm0 := range bms {
+		for j, bm1 := range bms {
+			before := bm1.Slice()
+			for _, tt := range []struct {
+				name    string
+				inPlace func(bm *roaring.Bitmap)
+				exp     *roaring.Bitmap
+			}{
+				{"union", func(bm *roaring.Bitmap) { bm.UnionInPlace(bm1, bms[(j+1)%len(bms)]) }, bm0.Union(bm1).Union(bms[(j+1)%len(bms)])},
+				{"intersect", func(bm *roaring.Bitmap) { bm.IntersectInPlace(bm1) }, bm0.Intersect(bm1)},
+				{"difference", func(bm *roaring.Bitmap) { bm.DifferenceInPlace(bm1) }, bm0.Difference(bm1)},
+				{"xor", func(bm *roaring.Bitmap) { bm.XorInPlace(bm1) }, bm0.Xor(bm1)},
+			} {
+				bm := bm0.Clone()
+				tt.inPlace(bm)
+				if got, exp := bm.Slice(), tt.exp.Slice(); !reflect.DeepEqual(got, exp) {
+					t.Fatalf("%d/%d %s: unexpected values: n=%d, expected=%d", i, j, tt.name, len(got), len(exp))
+				} else if got := bm.Count(); got != uint64(len(tt.exp.Slice())) {
+					t.Fatalf("%d/%d %s: unexpected count: %d", i, j, tt.name, got)
+				}
+			}
+
+			// Inputs must not be modified.
+			if !reflect.DeepEqual(bm1.Slice(), before) {
+				t.Fatalf("%d/%d: input modified", i, j)
+			}
+		}
+	}
+
+	// Operations with the receiver itself.
+	bm := bms[0].Clone()
+	if bm.UnionInPlace(bm); !reflect.DeepEqual(bm.Slice(), bms[0].Slice()) {
+		t.Fatal("unexpected self union")
+	} else if bm.IntersectInPlace(bm); !reflect.DeepEqual(bm.Slice(), bms[0].Slice()) {
+		t.Fatal("unexpected self intersect")
+	} else if bm.XorInPlace(bm); bm.Count() != 0 {
+		t.Fatalf("unexpected self xor: n=%d", bm.Count())
+	}
+}
+
 func TestBitmap_Xor(t *testing.T) {
 	bm0 := testBM()
 	bm1 := roaring.NewBitmap(0, 1, 2, 3)
@@ -948,6 +1089,148 @@ func testBitmapMarshalQuick(t *testing.T, n int, min, max uint64, sorted bool) {
 	})
 }
 
+// Ensure a partially written final op is reported with its offset.
+func TestBitmap_UnmarshalBinary_TornOp(t *testing.T) {
+	bm := roaring.NewBitmap(1, 2)
+	var buf bytes.Buffer
+	if _, err := bm.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	bm.OpWriter = &buf
+	if _, err := bm.Add(3); err != nil {
+		t.Fatal(err)
+	}
+	offset := buf.Len()
+	if _, err := bm.Add(4); err != nil {
+		t.Fatal(err)
+	}
+
+	// Drop the last byte of the final op.
+	data := buf.Bytes()[:buf.Len()-1]
+
+	bm2 := roaring.NewBitmap()
+	if err := bm2.UnmarshalBinary(data); err == nil {
+		t.Fatal("expected error")
+	} else if e, ok := err.(*roaring.TornOpError); !ok {
+		t.Fatalf("unexpected error: %s", err)
+	} else if e.Offset != offset {
+		t.Fatalf("unexpected offset: %d != %d", e.Offset, offset)
+	} else if got := bm2.Slice(); !reflect.DeepEqual(got, []uint64{1, 2, 3}) {
+		t.Fatalf("unexpected values: %v", got)
+	}
+
+	// Corrupting an op before the end of the log is not a torn op.
+	data = append([]byte{}, buf.Bytes()...)
+	data[offset-1] ^= 0xFF
+	if err := roaring.NewBitmap().UnmarshalBinary(data); err == nil {
+		t.Fatal("expected error")
+	} else if _, ok := err.(*roaring.TornOpError); ok {
+		t.Fatalf("unexpected torn op error: %s", err)
+	}
+}
+
+// Ensure bitmap can be encoded in the portable 32-bit format.
+func TestBitmap_WritePortable32To(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := roaring.NewBitmap(1, 2, 3).WritePortable32To(&buf); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(buf.Bytes(), []byte{
+		0x3A, 0x30, 0x00, 0x00, // cookie
+		0x01, 0x00, 0x00, 0x00, // container count
+		0x00, 0x00, 0x02, 0x00, // key & cardinality
+		0x10, 0x00, 0x00, 0x00, // offset
+		0x01, 0x00, 0x02, 0x00, 0x03, 0x00, // array
+	}) {
+		t.Fatalf("unexpected data: % x", buf.Bytes())
+	}
+
+	// Run containers are written with the run cookie and no offsets.
+	bm := roaring.NewBitmap()
+	for i := uint64(10); i < 20; i++ {
+		bm.Add(i)
+	}
+	bm.Optimize()
+	buf.Reset()
+	if _, err := bm.WritePortable32To(&buf); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(buf.Bytes(), []byte{
+		0x3B, 0x30, 0x00, 0x00, // cookie & container count
+		0x01,                   // run flags
+		0x00, 0x00, 0x09, 0x00, // key & cardinality
+		0x01, 0x00, 0x0A, 0x00, 0x09, 0x00, // runs
+	}) {
+		t.Fatalf("unexpected data: % x", buf.Bytes())
+	}
+
+	if _, err := roaring.NewBitmap(1 << 32).WritePortable32To(&buf); err != roaring.ErrValueTooLarge {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure bitmap can be round tripped through the portable formats.
+func TestBitmap_Portable_Quick(t *testing.T) {
+	if err := quick.Check(func(a []uint64, runs bool) bool {
+		bm := roaring.NewBitmap()
+		for _, v := range a {
+			bm.Add(v)
+		}
+		// Add a dense range to produce bitmap containers.
+		for i := uint64(0); i < 10000; i++ {
+			bm.Add(1<<20 + i*3)
+		}
+		if runs {
+			bm.Optimize()
+		}
+
+		var buf bytes.Buffer
+		if _, err := bm.WritePortable64To(&buf); err != nil {
+			t.Fatal(err)
+		}
+		other := roaring.NewBitmap()
+		if err := other.UnmarshalPortable64(buf.Bytes()); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(bm.Slice(), other.Slice()) {
+			t.Fatalf("unexpected 64-bit values: %d != %d", len(other.Slice()), len(bm.Slice()))
+		}
+
+		// Only values within 32 bits can use the 32-bit format.
+		bm32 := roaring.NewBitmap()
+		for _, v := range bm.Slice() {
+			if v <= math.MaxUint32 {
+				bm32.Add(v)
+			}
+		}
+		buf.Reset()
+		if _, err := bm32.WritePortable32To(&buf); err != nil {
+			t.Fatal(err)
+		}
+		other = roaring.NewBitmap()
+		if err := other.UnmarshalPortable32(buf.Bytes()); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(bm32.Slice(), other.Slice()) {
+			t.Fatalf("unexpected 32-bit values: %d != %d", len(other.Slice()), len(bm32.Slice()))
+		}
+		return true
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Ensure invalid portable data returns an error.
+func TestBitmap_UnmarshalPortable32_Invalid(t *testing.T) {
+	for i, data := range [][]byte{
+		{},
+		{0x00, 0x00, 0x00, 0x00},
+		{0x3A, 0x30, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00},
+		{0x3A, 0x30, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x10, 0x00, 0x00, 0x00, 0x01, 0x00},
+		{0x3A, 0x30, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xFF},
+	} {
+		if err := roaring.NewBitmap().UnmarshalPortable32(data); err == nil {
+			t.Fatalf("%d. expected error", i)
+		}
+	}
+}
+
 // Ensure iterator can iterate over all the values on the bitmap.
 // TODO duplicate for all container types
 func TestIterator(t *testing.T) {
@@ -1000,6 +1283,21 @@ func TestIterator(t *testing.T) {
 			}
 		}
 	})
+
+	// Seeking before a container starts from the container's first value.
+	t.Run("seek", func(t *testing.T) {
+		itr := roaring.NewBitmap(1, 1<<16+1, 1<<16+5, 3<<16+2).Iterator()
+		itr.Seek(100)
+
+		var a []uint64
+		for v, eof := itr.Next(); !eof; v, eof = itr.Next() {
+			a = append(a, v)
+		}
+
+		if !reflect.DeepEqual(a, []uint64{1<<16 + 1, 1<<16 + 5, 3<<16 + 2}) {
+			t.Fatalf("unexpected values: %+v", a)
+		}
+	})
 }
 
 // testBM creates a bitmap with 3 containers: array, bitmap, and run.
@@ -177,12 +177,20 @@ func (s *Server) Open() error {
 	e.Cluster = s.Cluster
 	e.MaxWritesPerRequest = s.MaxWritesPerRequest
 
+	// Create translator for converting keys to IDs across the cluster.
+	t := NewTranslator(&ClientOptions{TLS: s.TLS})
+	t.Holder = s.Holder
+	t.Host = s.URI.HostPort()
+	t.Cluster = s.Cluster
+	e.Translator = t
+
 	// Initialize HTTP handler.
 	s.Handler.Broadcaster = s.Broadcaster
 	s.Handler.StatusHandler = s
 	s.Handler.URI = s.URI
 	s.Handler.Cluster = s.Cluster
 	s.Handler.Executor = e
+	s.Handler.Translator = t
 	s.Handler.LogOutput = s.LogOutput
 
 	// Initialize Holder.
@@ -326,6 +334,7 @@ func (s *Server) ReceiveMessage(pb proto.Message) error {
 		opt := IndexOptions{
 			ColumnLabel: obj.Meta.ColumnLabel,
 			TimeQuantum: TimeQuantum(obj.Meta.TimeQuantum),
+			Keys:        obj.Meta.Keys,
 		}
 		_, err := s.Holder.CreateIndex(obj.Index, opt)
 		if err != nil {
@@ -341,13 +350,16 @@ func (s *Server) ReceiveMessage(pb proto.Message) error {
 			return fmt.Errorf("Local Index not found: %s", obj.Index)
 		}
 		opt := FrameOptions{
-			RowLabel:       obj.Meta.RowLabel,
-			InverseEnabled: obj.Meta.InverseEnabled,
-			RangeEnabled:   obj.Meta.RangeEnabled,
-			CacheType:      obj.Meta.CacheType,
-			CacheSize:      obj.Meta.CacheSize,
-			TimeQuantum:    TimeQuantum(obj.Meta.TimeQuantum),
-			Fields:         decodeFields(obj.Meta.Fields),
+			RowLabel:        obj.Meta.RowLabel,
+			InverseEnabled:  obj.Meta.InverseEnabled,
+			RangeEnabled:    obj.Meta.RangeEnabled,
+			CacheType:       obj.Meta.CacheType,
+			CacheSize:       obj.Meta.CacheSize,
+			TimeQuantum:     TimeQuantum(obj.Meta.TimeQuantum),
+			Fields:          decodeFields(obj.Meta.Fields),
+			Keys:            obj.Meta.Keys,
+			Compression:     obj.Meta.Compression,
+			CompressedViews: obj.Meta.CompressedViews,
 		}
 		_, err := idx.CreateFrame(obj.Frame, opt)
 		if err != nil {
@@ -379,10 +391,84 @@ func (s *Server) ReceiveMessage(pb proto.Message) error {
 		if err != nil {
 			return err
 		}
+	case *internal.CreateFieldMessage:
+		f := s.Holder.Frame(obj.Index, obj.Frame)
+		if f == nil {
+			return fmt.Errorf("Local Frame not found: %s", obj.Frame)
+		}
+		if err := f.CreateField(decodeField(obj.Field)); err != nil {
+			return err
+		}
+	case *internal.DeleteFieldMessage:
+		f := s.Holder.Frame(obj.Index, obj.Frame)
+		if f == nil {
+			return fmt.Errorf("Local Frame not found: %s", obj.Frame)
+		}
+		if err := f.DeleteField(obj.Field); err != nil {
+			return err
+		}
+	case *internal.SetIndexTimeQuantumMessage:
+		idx := s.Holder.Index(obj.Index)
+		if idx == nil {
+			return fmt.Errorf("Local Index not found: %s", obj.Index)
+		}
+		if err := idx.SetTimeQuantum(TimeQuantum(obj.TimeQuantum)); err != nil {
+			return err
+		}
+	case *internal.SetFrameTimeQuantumMessage:
+		f := s.Holder.Frame(obj.Index, obj.Frame)
+		if f == nil {
+			return fmt.Errorf("Local Frame not found: %s", obj.Frame)
+		}
+		if err := f.SetTimeQuantum(TimeQuantum(obj.TimeQuantum)); err != nil {
+			return err
+		}
+	case *internal.RerangeFieldMessage:
+		f := s.Holder.Frame(obj.Index, obj.Frame)
+		if f == nil {
+			return fmt.Errorf("Local Frame not found: %s", obj.Frame)
+		}
+		if err := f.RerangeField(obj.Field, obj.Min, obj.Max); err != nil {
+			return err
+		}
+	case *internal.CreateAttrIndexMessage:
+		store, err := s.attrStore(obj.Index, obj.Frame)
+		if err != nil {
+			return err
+		}
+		if err := store.CreateIndex(obj.Attr); err != nil {
+			return err
+		}
+	case *internal.DeleteAttrIndexMessage:
+		store, err := s.attrStore(obj.Index, obj.Frame)
+		if err != nil {
+			return err
+		}
+		if err := store.DeleteIndex(obj.Attr); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// attrStore returns the row attribute store for a frame or, if frame is
+// blank, the column attribute store for an index.
+func (s *Server) attrStore(index, frame string) (*AttrStore, error) {
+	if frame == "" {
+		idx := s.Holder.Index(index)
+		if idx == nil {
+			return nil, fmt.Errorf("Local Index not found: %s", index)
+		}
+		return idx.ColumnAttrStore(), nil
+	}
+
+	f := s.Holder.Frame(index, frame)
+	if f == nil {
+		return nil, fmt.Errorf("Local Frame not found: %s", frame)
+	}
+	return f.RowAttrStore(), nil
+}
+
 // LocalStatus returns the state of the local node as well as the
 // holder (indexes/frames) according to the local node.
 // In a gossip implementation, memberlist.Delegate.LocalState() uses this.
@@ -449,6 +535,7 @@ func (s *Server) mergeRemoteStatus(ns *internal.NodeStatus) error {
 		opt := IndexOptions{
 			ColumnLabel: index.Meta.ColumnLabel,
 			TimeQuantum: TimeQuantum(index.Meta.TimeQuantum),
+			Keys:        index.Meta.Keys,
 		}
 		idx, err := s.Holder.CreateIndexIfNotExists(index.Name, opt)
 		if err != nil {
@@ -457,9 +544,16 @@ func (s *Server) mergeRemoteStatus(ns *internal.NodeStatus) error {
 		// Create frames that don't exist.
 		for _, f := range index.Frames {
 			opt := FrameOptions{
-				RowLabel:    f.Meta.RowLabel,
-				TimeQuantum: TimeQuantum(f.Meta.TimeQuantum),
-				CacheSize:   f.Meta.CacheSize,
+				RowLabel:        f.Meta.RowLabel,
+				InverseEnabled:  f.Meta.InverseEnabled,
+				RangeEnabled:    f.Meta.RangeEnabled,
+				CacheType:       f.Meta.CacheType,
+				CacheSize:       f.Meta.CacheSize,
+				TimeQuantum:     TimeQuantum(f.Meta.TimeQuantum),
+				Fields:          decodeFields(f.Meta.Fields),
+				Keys:            f.Meta.Keys,
+				Compression:     f.Meta.Compression,
+				CompressedViews: f.Meta.CompressedViews,
 			}
 			_, err := idx.CreateFrameIfNotExists(f.Name, opt)
 			if err != nil {
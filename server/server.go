@@ -146,6 +146,8 @@ func (m *Command) SetupServer() error {
 	// Configure holder.
 	m.Server.Logger().Printf("Using data from: %s\n", m.Config.DataDir)
 	m.Server.Holder.Path = m.Config.DataDir
+	m.Server.Holder.FsyncPolicy = m.Config.Storage.Fsync
+	m.Server.Holder.FsyncInterval = time.Duration(m.Config.Storage.FsyncInterval)
 	m.Server.MetricInterval = time.Duration(m.Config.Metric.PollInterval)
 	if m.Config.Metric.Diagnostics {
 		m.Server.DiagnosticInterval = time.Duration(DefaultDiagnosticsInterval)
@@ -34,7 +34,9 @@ import (
 	"testing/quick"
 
 	"github.com/BurntSushi/toml"
+	"github.com/gogo/protobuf/proto"
 	"github.com/pilosa/pilosa"
+	"github.com/pilosa/pilosa/internal"
 	"github.com/pilosa/pilosa/server"
 	"github.com/pilosa/pilosa/test"
 )
@@ -360,6 +362,48 @@ func TestConfig_Parse_DataDir(t *testing.T) {
 	}
 }
 
+// Ensure the server applies field & time quantum broadcast messages.
+func TestMain_ReceiveMessage_Schema(t *testing.T) {
+	m := MustRunMain()
+	defer m.Close()
+
+	idx, err := m.Server.Holder.CreateIndex("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err := idx.CreateFrame("f", pilosa.FrameOptions{RangeEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Apply messages as if broadcast from another node.
+	for _, msg := range []proto.Message{
+		&internal.SetIndexTimeQuantumMessage{Index: "i", TimeQuantum: "YMD"},
+		&internal.SetFrameTimeQuantumMessage{Index: "i", Frame: "f", TimeQuantum: "YM"},
+		&internal.CreateFieldMessage{Index: "i", Frame: "f", Field: &internal.Field{Name: "x", Type: pilosa.FieldTypeInt, Min: 0, Max: 10}},
+		&internal.CreateFieldMessage{Index: "i", Frame: "f", Field: &internal.Field{Name: "y", Type: pilosa.FieldTypeInt, Min: 0, Max: 10}},
+		&internal.DeleteFieldMessage{Index: "i", Frame: "f", Field: "y"},
+	} {
+		if err := m.Server.ReceiveMessage(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f := m.Server.Holder.Frame("i", "f")
+	if q := idx.TimeQuantum(); q != "YMD" {
+		t.Fatalf("unexpected index time quantum: %s", q)
+	} else if q := f.TimeQuantum(); q != "YM" {
+		t.Fatalf("unexpected frame time quantum: %s", q)
+	} else if f.Field("x") == nil {
+		t.Fatal("expected field x to exist")
+	} else if f.Field("y") != nil {
+		t.Fatal("expected field y to be deleted")
+	}
+
+	// Messages for unknown frames return an error.
+	if err := m.Server.ReceiveMessage(&internal.DeleteFieldMessage{Index: "i", Frame: "no_such_frame", Field: "x"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 // tempMkdir makes a temporary directory
 func tempMkdir(t *testing.T) string {
 	dir, err := ioutil.TempDir("", "pilosatemp")
@@ -21,6 +21,14 @@ func NewExecutor(holder *pilosa.Holder, cluster *pilosa.Cluster) *Executor {
 	e.Cluster = cluster
 	e.Scheme = cluster.Nodes[0].Scheme
 	e.Host = cluster.Nodes[0].Host
+
+	// Translate keys through the same node as the executor.
+	t := pilosa.NewTranslator(nil)
+	t.Holder = holder
+	t.Host = e.Host
+	t.Cluster = cluster
+	e.Translator = t
+
 	return e
 }
 
@@ -40,6 +40,7 @@ func NewHandler() *Handler {
 type HandlerExecutor struct {
 	cluster   *pilosa.Cluster
 	ExecuteFn func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]interface{}, error)
+	ExplainFn func(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]*pilosa.CallPlan, error)
 }
 
 func (c *HandlerExecutor) Cluster() *pilosa.Cluster { return c.cluster }
@@ -48,6 +49,10 @@ func (c *HandlerExecutor) Execute(ctx context.Context, index string, query *pql.
 	return c.ExecuteFn(ctx, index, query, slices, opt)
 }
 
+func (c *HandlerExecutor) Explain(ctx context.Context, index string, query *pql.Query, slices []uint64, opt *pilosa.ExecOptions) ([]*pilosa.CallPlan, error) {
+	return c.ExplainFn(ctx, index, query, slices, opt)
+}
+
 // Server represents a test wrapper for httptest.Server.
 type Server struct {
 	*httptest.Server
@@ -0,0 +1,48 @@
+package test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/pilosa/pilosa"
+)
+
+// TranslateStore represents a test wrapper for pilosa.TranslateStore.
+type TranslateStore struct {
+	*pilosa.TranslateStore
+}
+
+// NewTranslateStore returns a new instance of TranslateStore.
+func NewTranslateStore() *TranslateStore {
+	f, err := ioutil.TempFile("", "pilosa-translate-")
+	if err != nil {
+		panic(err)
+	}
+	f.Close()
+	os.Remove(f.Name())
+
+	return &TranslateStore{TranslateStore: pilosa.NewTranslateStore(f.Name())}
+}
+
+// MustOpenTranslateStore returns a new, opened translate store at a temporary path. Panic on error.
+func MustOpenTranslateStore() *TranslateStore {
+	s := NewTranslateStore()
+	if err := s.Open(); err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Close closes the database and removes the underlying data.
+func (s *TranslateStore) Close() error {
+	defer os.RemoveAll(s.Path())
+	return s.TranslateStore.Close()
+}
+
+// Reopen closes and reopens the store.
+func (s *TranslateStore) Reopen() error {
+	if err := s.TranslateStore.Close(); err != nil {
+		return err
+	}
+	return s.Open()
+}
@@ -0,0 +1,103 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// List of timestamp field units. An empty unit is treated as seconds.
+const (
+	TimeUnitSeconds      = "s"
+	TimeUnitMilliseconds = "ms"
+	TimeUnitMicroseconds = "us"
+	TimeUnitNanoseconds  = "ns"
+)
+
+// IsValidTimeUnit returns true if v is a valid timestamp field unit.
+func IsValidTimeUnit(v string) bool {
+	switch v {
+	case "", TimeUnitSeconds, TimeUnitMilliseconds, TimeUnitMicroseconds, TimeUnitNanoseconds:
+		return true
+	default:
+		return false
+	}
+}
+
+// timeUnitDuration returns the duration of a single unit.
+func timeUnitDuration(unit string) time.Duration {
+	switch unit {
+	case TimeUnitMilliseconds:
+		return time.Millisecond
+	case TimeUnitMicroseconds:
+		return time.Microsecond
+	case TimeUnitNanoseconds:
+		return time.Nanosecond
+	default:
+		return time.Second
+	}
+}
+
+// timestampLayouts are the layouts accepted by ParseTimestamp, in order.
+// Layouts without a zone are interpreted as UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	TimeFormat,
+	"2006-01-02",
+}
+
+// ParseTimestamp parses an RFC3339 timestamp, such as "2017-01-01T00:00:00Z",
+// into the number of units since the Unix epoch. The seconds and zone may be
+// omitted, as in "2017-01-01T00:00", or the time entirely.
+func ParseTimestamp(s string, unit string) (int64, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return TimestampValue(t, unit)
+		}
+	}
+	return 0, errors.New("invalid timestamp: " + s)
+}
+
+// TimestampValue converts t into the number of units since the Unix epoch.
+// Returns ErrFieldValuePrecision if t is more precise than unit.
+func TimestampValue(t time.Time, unit string) (int64, error) {
+	d := int64(timeUnitDuration(unit))
+	perSec := int64(time.Second) / d
+	sec, nsec := t.Unix(), int64(t.Nanosecond())
+	if nsec%d != 0 {
+		return 0, ErrFieldValuePrecision
+	}
+
+	// Ensure the value fits in an int64.
+	if sec > (math.MaxInt64-nsec/d)/perSec || sec < math.MinInt64/perSec {
+		return 0, errors.New("timestamp out of range: " + t.Format(time.RFC3339Nano))
+	}
+	return sec*perSec + nsec/d, nil
+}
+
+// FormatTimestamp formats v, a number of units since the Unix epoch, as an
+// RFC3339 timestamp in UTC.
+func FormatTimestamp(v int64, unit string) string {
+	d := int64(timeUnitDuration(unit))
+	perSec := int64(time.Second) / d
+	sec, rem := v/perSec, v%perSec
+	if rem < 0 {
+		sec, rem = sec-1, rem+perSec
+	}
+	return time.Unix(sec, rem*d).UTC().Format(time.RFC3339Nano)
+}
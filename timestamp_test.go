@@ -0,0 +1,73 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa_test
+
+import (
+	"testing"
+
+	"github.com/pilosa/pilosa"
+)
+
+// Ensure timestamps can be parsed into epoch units.
+func TestParseTimestamp(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		unit string
+		exp  int64
+		err  string
+	}{
+		{s: "1970-01-01T00:00:00Z", unit: pilosa.TimeUnitSeconds, exp: 0},
+		{s: "2017-01-01T00:00", unit: pilosa.TimeUnitSeconds, exp: 1483228800},
+		{s: "2017-01-01T00:00", unit: "", exp: 1483228800},
+		{s: "2017-01-01", unit: pilosa.TimeUnitSeconds, exp: 1483228800},
+		{s: "2017-01-01T01:00:00+01:00", unit: pilosa.TimeUnitSeconds, exp: 1483228800},
+		{s: "2017-01-01T00:00:00.5Z", unit: pilosa.TimeUnitMilliseconds, exp: 1483228800500},
+		{s: "1969-12-31T23:59:59.999999Z", unit: pilosa.TimeUnitMicroseconds, exp: -1},
+		{s: "2017-01-01T00:00:00.000000001Z", unit: pilosa.TimeUnitNanoseconds, exp: 1483228800000000001},
+		{s: "2017-01-01T00:00:00.5Z", unit: pilosa.TimeUnitSeconds, err: pilosa.ErrFieldValuePrecision.Error()},
+		{s: "2300-01-01T00:00:00Z", unit: pilosa.TimeUnitNanoseconds, err: "timestamp out of range: 2300-01-01T00:00:00Z"},
+		{s: "yesterday", unit: pilosa.TimeUnitSeconds, err: "invalid timestamp: yesterday"},
+	} {
+		v, err := pilosa.ParseTimestamp(tt.s, tt.unit)
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err {
+				t.Fatalf("%q: unexpected error: %v", tt.s, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatalf("%q: %s", tt.s, err)
+		} else if v != tt.exp {
+			t.Fatalf("%q: unexpected value: %d", tt.s, v)
+		}
+	}
+}
+
+// Ensure epoch units can be formatted as timestamps.
+func TestFormatTimestamp(t *testing.T) {
+	for _, tt := range []struct {
+		v    int64
+		unit string
+		exp  string
+	}{
+		{v: 0, unit: pilosa.TimeUnitSeconds, exp: "1970-01-01T00:00:00Z"},
+		{v: 1483228800, unit: "", exp: "2017-01-01T00:00:00Z"},
+		{v: 1483228800500, unit: pilosa.TimeUnitMilliseconds, exp: "2017-01-01T00:00:00.5Z"},
+		{v: -1, unit: pilosa.TimeUnitMicroseconds, exp: "1969-12-31T23:59:59.999999Z"},
+	} {
+		if s := pilosa.FormatTimestamp(tt.v, tt.unit); s != tt.exp {
+			t.Fatalf("%d: unexpected timestamp: %s", tt.v, s)
+		}
+	}
+}
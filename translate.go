@@ -0,0 +1,272 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// TranslateFile is the name of the key translation file in an index or frame directory.
+const TranslateFile = ".keys"
+
+// TranslateStore represents a storage layer for translating string keys to IDs.
+type TranslateStore struct {
+	mu   sync.Mutex
+	path string
+	db   *bolt.DB
+}
+
+// NewTranslateStore returns a new instance of TranslateStore.
+func NewTranslateStore(path string) *TranslateStore {
+	return &TranslateStore{path: path}
+}
+
+// Path returns path to the store's data file.
+func (s *TranslateStore) Path() string { return s.path }
+
+// Open opens and initializes the store.
+func (s *TranslateStore) Open() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Ignore if already open.
+	if s.db != nil {
+		return nil
+	}
+
+	// Open storage.
+	db, err := bolt.Open(s.path, 0666, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return err
+	}
+	s.db = db
+
+	// Initialize database.
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists([]byte("keys")); err != nil {
+			return err
+		} else if _, err := tx.CreateBucketIfNotExists([]byte("ids")); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close closes the store.
+func (s *TranslateStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.db != nil {
+		s.db.Close()
+		s.db = nil
+	}
+	return nil
+}
+
+// TranslateKeys returns the IDs for a list of keys.
+// IDs are allocated for keys which have not been seen before.
+func (s *TranslateStore) TranslateKeys(keys []string) ([]uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]uint64, len(keys))
+
+	// Look up existing keys first so reads don't require a write transaction.
+	var missing bool
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte("keys"))
+		for i, key := range keys {
+			if v := bkt.Get([]byte(key)); v != nil {
+				ids[i] = binary.BigEndian.Uint64(v)
+			} else {
+				missing = true
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	} else if !missing {
+		return ids, nil
+	}
+
+	// Allocate IDs for any new keys.
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		keyBkt, idBkt := tx.Bucket([]byte("keys")), tx.Bucket([]byte("ids"))
+		for i, key := range keys {
+			if ids[i] != 0 {
+				continue
+			} else if v := keyBkt.Get([]byte(key)); v != nil {
+				ids[i] = binary.BigEndian.Uint64(v)
+				continue
+			}
+
+			id, err := keyBkt.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			buf := make([]byte, 8)
+			binary.BigEndian.PutUint64(buf, id)
+			if err := keyBkt.Put([]byte(key), buf); err != nil {
+				return err
+			} else if err := idBkt.Put(buf, []byte(key)); err != nil {
+				return err
+			}
+			ids[i] = id
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// LookupKeys returns the IDs for a list of keys without allocating new IDs.
+// Zero is returned for keys which have not been seen before.
+func (s *TranslateStore) LookupKeys(keys []string) ([]uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]uint64, len(keys))
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte("keys"))
+		for i, key := range keys {
+			if v := bkt.Get([]byte(key)); v != nil {
+				ids[i] = binary.BigEndian.Uint64(v)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// TranslateIDs returns the keys for a list of IDs.
+// A blank key is returned for IDs which were not allocated by the store.
+func (s *TranslateStore) TranslateIDs(ids []uint64) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, len(ids))
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte("ids"))
+		buf := make([]byte, 8)
+		for i, id := range ids {
+			binary.BigEndian.PutUint64(buf, id)
+			keys[i] = string(bkt.Get(buf))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Translator translates between keys & IDs for the cluster.
+// All translation is performed by the first node in the cluster so that
+// every node agrees on the same mapping.
+type Translator struct {
+	Holder *Holder
+
+	// Local hostname & cluster configuration.
+	Host    string
+	Cluster *Cluster
+
+	// Client used for remote requests.
+	client InternalClient
+}
+
+// NewTranslator returns a new instance of Translator.
+func NewTranslator(clientOptions *ClientOptions) *Translator {
+	if clientOptions == nil {
+		clientOptions = &ClientOptions{}
+	}
+	return &Translator{
+		client: NewInternalHTTPClientFromURI(nil, clientOptions),
+	}
+}
+
+// TranslateKeys returns the column IDs for keys in an index or, if frame
+// is specified, the row IDs for keys in a frame.
+func (t *Translator) TranslateKeys(ctx context.Context, index, frame string, keys []string) ([]uint64, error) {
+	if uri, err := t.remoteURI(); err != nil {
+		return nil, err
+	} else if uri != nil {
+		return t.client.TranslateKeys(context.WithValue(ctx, "uri", uri), index, frame, keys)
+	}
+
+	store, err := t.Holder.TranslateStore(index, frame)
+	if err != nil {
+		return nil, err
+	}
+	return store.TranslateKeys(keys)
+}
+
+// LookupKeys returns the IDs for keys like TranslateKeys but does not
+// allocate IDs for unknown keys. Zero is returned for unknown keys.
+func (t *Translator) LookupKeys(ctx context.Context, index, frame string, keys []string) ([]uint64, error) {
+	if uri, err := t.remoteURI(); err != nil {
+		return nil, err
+	} else if uri != nil {
+		return t.client.LookupKeys(context.WithValue(ctx, "uri", uri), index, frame, keys)
+	}
+
+	store, err := t.Holder.TranslateStore(index, frame)
+	if err != nil {
+		return nil, err
+	}
+	return store.LookupKeys(keys)
+}
+
+// TranslateIDs returns the keys for column IDs in an index or, if frame
+// is specified, the keys for row IDs in a frame.
+func (t *Translator) TranslateIDs(ctx context.Context, index, frame string, ids []uint64) ([]string, error) {
+	if uri, err := t.remoteURI(); err != nil {
+		return nil, err
+	} else if uri != nil {
+		return t.client.TranslateIDs(context.WithValue(ctx, "uri", uri), index, frame, ids)
+	}
+
+	store, err := t.Holder.TranslateStore(index, frame)
+	if err != nil {
+		return nil, err
+	}
+	return store.TranslateIDs(ids)
+}
+
+// remoteURI returns the URI of the translating node.
+// Returns nil if translation is performed by the local node.
+func (t *Translator) remoteURI() (*URI, error) {
+	if t.Cluster == nil || len(t.Cluster.Nodes) == 0 {
+		return nil, nil
+	}
+
+	node := t.Cluster.Nodes[0]
+	if node.Host == t.Host {
+		return nil, nil
+	}
+	return node.URI()
+}
@@ -0,0 +1,156 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/pilosa/pilosa"
+	"github.com/pilosa/pilosa/test"
+)
+
+// Ensure keys are translated to stable IDs.
+func TestTranslateStore_TranslateKeys(t *testing.T) {
+	s := test.MustOpenTranslateStore()
+	defer s.Close()
+
+	// Allocate new keys, including a duplicate.
+	ids, err := s.TranslateKeys([]string{"foo", "bar", "foo"})
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1, 2, 1}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	// Existing keys should be reused alongside new keys.
+	if ids, err := s.TranslateKeys([]string{"baz", "bar"}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{3, 2}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	// Verify the mapping persists after reopening.
+	if err := s.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if ids, err := s.TranslateKeys([]string{"foo", "bar", "baz", "qux"}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1, 2, 3, 4}) {
+		t.Fatalf("unexpected ids (reopen): %v", ids)
+	}
+}
+
+// Ensure keys can be looked up without allocating new IDs.
+func TestTranslateStore_LookupKeys(t *testing.T) {
+	s := test.MustOpenTranslateStore()
+	defer s.Close()
+
+	if _, err := s.TranslateKeys([]string{"foo", "bar"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unknown keys are returned as zero.
+	if ids, err := s.LookupKeys([]string{"bar", "baz", "foo"}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{2, 0, 1}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	// Verify the lookup did not allocate an ID for the unknown key.
+	if ids, err := s.TranslateKeys([]string{"qux"}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{3}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+}
+
+// Ensure IDs are translated back to their keys.
+func TestTranslateStore_TranslateIDs(t *testing.T) {
+	s := test.MustOpenTranslateStore()
+	defer s.Close()
+
+	if _, err := s.TranslateKeys([]string{"foo", "bar"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unknown IDs are returned as blank keys.
+	if keys, err := s.TranslateIDs([]uint64{2, 1, 100}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(keys, []string{"bar", "foo", ""}) {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+}
+
+// Ensure translation is forwarded to the first node in the cluster.
+func TestTranslator_Remote(t *testing.T) {
+	hldr := test.MustOpenHolder()
+	defer hldr.Close()
+	idx := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{Keys: true})
+	if _, err := idx.CreateFrame("f", pilosa.FrameOptions{Keys: true}); err != nil {
+		t.Fatal(err)
+	} else if _, err := idx.CreateFrame("g", pilosa.FrameOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first node owns all translation.
+	s := test.NewServer()
+	defer s.Close()
+	s.Handler.Holder = hldr.Holder
+
+	c := test.NewCluster(2)
+	c.Nodes[0].Host = s.Host()
+
+	// Translate from the second node.
+	tr := pilosa.NewTranslator(nil)
+	tr.Holder = test.MustOpenHolder().Holder
+	defer tr.Holder.Close()
+	tr.Host = c.Nodes[1].Host
+	tr.Cluster = c
+
+	if ids, err := tr.TranslateKeys(context.Background(), "i", "", []string{"foo", "bar"}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1, 2}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+	if ids, err := tr.TranslateKeys(context.Background(), "i", "f", []string{"baz"}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	if ids, err := tr.LookupKeys(context.Background(), "i", "f", []string{"baz", "qux"}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(ids, []uint64{1, 0}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	// Verify keys were stored on the first node.
+	if keys, err := idx.TranslateStore().TranslateIDs([]uint64{2, 1}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(keys, []string{"bar", "foo"}) {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+	if keys, err := tr.TranslateIDs(context.Background(), "i", "f", []uint64{1}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(keys, []string{"baz"}) {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+
+	// Frames without keys return an error.
+	if _, err := tr.TranslateKeys(context.Background(), "i", "g", []string{"x"}); err == nil || err.Error() != pilosa.ErrFrameKeysNotEnabled.Error() {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
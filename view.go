@@ -62,6 +62,12 @@ type View struct {
 	broadcaster Broadcaster
 	stats       StatsClient
 
+	syncer        *fragmentSyncer
+	snapshotQueue *snapshotQueue
+
+	// Compression codec for fragment snapshots.
+	compression string
+
 	RowAttrStore *AttrStore
 	LogOutput    io.Writer
 }
@@ -265,8 +271,14 @@ func (v *View) newFragment(path string, slice uint64) *Fragment {
 	frag := NewFragment(path, v.index, v.frame, v.name, slice)
 	frag.CacheType = v.cacheType
 	frag.CacheSize = v.cacheSize
+	frag.Compression = v.compression
 	frag.LogOutput = v.LogOutput
 	frag.stats = v.stats.WithTags(fmt.Sprintf("slice:%d", slice))
+	if v.syncer != nil {
+		frag.FsyncPolicy = v.syncer.policy
+		frag.syncer = v.syncer
+	}
+	frag.snapshotQueue = v.snapshotQueue
 	return frag
 }
 